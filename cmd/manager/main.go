@@ -17,12 +17,13 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
 	"net/http"
 	"os"
+	"path/filepath"
 	"time"
 
-	"github.com/google/go-containerregistry/pkg/v1/cache"
 	"go.uber.org/zap/zapcore"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -34,6 +35,7 @@ import (
 	ctrlcache "sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	// credential providers
@@ -53,10 +55,11 @@ const (
 )
 
 var (
-	scheme     = runtime.NewScheme()
-	setupLog   = ctrl.Log.WithName("setup")
-	syncPeriod = 10 * time.Hour
-	namespace  = os.Getenv("SYSTEM_NAMESPACE")
+	scheme             = runtime.NewScheme()
+	setupLog           = ctrl.Log.WithName("setup")
+	syncPeriod         = 10 * time.Hour
+	namespace          = os.Getenv("SYSTEM_NAMESPACE")
+	serviceAccountName = os.Getenv("SYSTEM_SERVICE_ACCOUNT")
 )
 
 func init() {
@@ -67,15 +70,40 @@ func init() {
 }
 
 func main() {
+	// exit once the deferred cleanup in run, like flushing traces, has completed
+	if err := run(); err != nil {
+		os.Exit(1)
+	}
+}
+
+// run starts the manager, returning when it stops. Errors are logged before they are returned.
+func run() error {
 	ctx := ctrl.SetupSignalHandler()
 
 	var metricsAddr string
 	var probesAddr string
 	var enableLeaderElection bool
+	var cacheMaxBytes int64
+	var cacheMaxAge time.Duration
+	var sbomMaxFileBytes int64
+	var sbomMaxImageBytes int64
+	var tracing tracingOptions
+	var circuitBreaker binding.CircuitBreakerOptions
+	var clientCertDir string
 	flag.StringVar(&metricsAddr, "metrics-addr", ":0", "The address the metric endpoint binds to.")
 	flag.StringVar(&probesAddr, "probes-addr", ":8081", "The address health probes bind to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.Int64Var(&cacheMaxBytes, "cache-max-bytes", 1<<30, "The maximum size in bytes of the image layer cache. Least recently used layers are evicted beyond this size, zero disables the limit.")
+	flag.Int64Var(&sbomMaxFileBytes, "sbom-max-file-bytes", 4<<20, "The maximum size in bytes of a single SBOM file loaded from an image, larger files are not sent to conventions. Zero disables the limit.")
+	flag.Int64Var(&sbomMaxImageBytes, "sbom-max-image-bytes", 16<<20, "The maximum combined size in bytes of the SBOM files loaded from an image. Zero disables the limit.")
+	flag.DurationVar(&cacheMaxAge, "cache-max-age", 7*24*time.Hour, "The maximum duration an unused image layer is kept in the cache, zero disables the limit.")
+	flag.IntVar(&circuitBreaker.FailureThreshold, "convention-failure-threshold", 5, "The number of consecutive failed requests to a convention that open its circuit, failing requests without calling the convention. Zero disables the circuit breaker.")
+	flag.DurationVar(&circuitBreaker.OpenDuration, "convention-open-duration", 30*time.Second, "How long the circuit for a failing convention stays open before a trial request is sent.")
+	flag.StringVar(&clientCertDir, "convention-client-cert-dir", "", "The directory containing tls.crt and tls.key, presented as a client certificate to convention servers. Client certificates are not presented when empty.")
+	flag.StringVar(&tracing.Endpoint, "tracing-otlp-endpoint", "", "The host:port of an OTLP gRPC collector to export traces to. Tracing is disabled when empty.")
+	flag.BoolVar(&tracing.Insecure, "tracing-otlp-insecure", false, "Connect to the OTLP collector without TLS.")
+	flag.Float64Var(&tracing.SampleRatio, "tracing-sample-ratio", 1, "The ratio of new traces that are sampled, between 0 and 1. Traces continued from a parent follow the parent's sampling decision.")
 	opts := zap.Options{
 		TimeEncoder: zapcore.RFC3339NanoTimeEncoder,
 	}
@@ -84,7 +112,24 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	shutdownTracing, err := setupTracing(ctx, tracing)
+	if err != nil {
+		setupLog.Error(err, "there was an error configuring tracing")
+		return err
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			setupLog.Error(err, "there was an error flushing traces")
+		}
+	}()
+
+	// GetConfigOrDie would exit without flushing traces
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		setupLog.Error(err, "there was an error loading the kubeconfig")
+		return err
+	}
+	mgr, err := ctrl.NewManager(config, ctrl.Options{
 		Scheme: scheme,
 		Metrics: server.Options{
 			BindAddress: metricsAddr,
@@ -106,26 +151,60 @@ func main() {
 	})
 	if err != nil {
 		setupLog.Error(err, "there was an error initializing a new `Manager` object")
-		os.Exit(1)
+		return err
 	}
 	client, err := kubernetes.NewForConfig(mgr.GetConfig())
 	if err != nil {
 		setupLog.Error(err, "there was an error creating a new clientset using config provided")
-		os.Exit(1)
+		return err
 	}
 	authInfoResolver, err := webhookutil.NewDefaultAuthenticationInfoResolver("")
 	if err != nil {
 		setupLog.Error(err, "there was an error creating a new authentication info resolver object")
-		os.Exit(1)
+		return err
 	}
+	if clientCertDir != "" {
+		authInfoResolver, err = binding.WithClientCertificate(authInfoResolver, binding.ClientCertificate{
+			CertFile: filepath.Join(clientCertDir, "tls.crt"),
+			KeyFile:  filepath.Join(clientCertDir, "tls.key"),
+		})
+		if err != nil {
+			setupLog.Error(err, "there was an error loading the client certificate for convention servers")
+			return err
+		}
+	}
+	circuitBreakers := binding.NewCircuitBreakers(circuitBreaker)
 	wc := binding.WebhookConfig{
 		AuthInfoResolver: authInfoResolver,
 		ServiceResolver:  webhookutil.NewDefaultServiceResolver(),
+		CircuitBreakers:  circuitBreakers,
+	}
+	if serviceAccountName != "" {
+		wc.ServiceAccountTokens = binding.NewServiceAccountTokens(client, namespace, serviceAccountName)
+	}
+	layerCache, err := binding.NewBoundedCache(cacheMountPath, binding.BoundedCacheOptions{
+		MaxBytes: cacheMaxBytes,
+		MaxAge:   cacheMaxAge,
+	})
+	if err != nil {
+		setupLog.Error(err, "there was an error creating the image layer cache")
+		return err
+	}
+	if err := mgr.Add(layerCache); err != nil {
+		setupLog.Error(err, "unable to add image layer cache garbage collector")
+		return err
+	}
+	if err := metrics.Registry.Register(binding.NewCacheCollector(layerCache)); err != nil {
+		setupLog.Error(err, "unable to register image layer cache metrics")
+		return err
 	}
 	rc := binding.RegistryConfig{
-		Cache:      cache.NewFilesystemCache(cacheMountPath),
+		Cache:      layerCache,
 		Client:     client,
 		CACertPath: additionalCAMountPath,
+
+		MaxSBOMFileBytes:  sbomMaxFileBytes,
+		MaxSBOMImageBytes: sbomMaxImageBytes,
 	}
 	// extension controllers
 
@@ -135,22 +214,36 @@ func main() {
 		rc,
 	).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "PodIntent")
-		os.Exit(1)
+		return err
+	}
+	if err = controllers.ClusterPodConventionReconciler(
+		reconcilers.NewConfig(mgr, &conventionsv1alpha1.ClusterPodConvention{}, syncPeriod),
+		circuitBreakers,
+	).SetupWithManager(ctx, mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterPodConvention")
+		return err
 	}
 	if err = ctrl.NewWebhookManagedBy(mgr, &conventionsv1alpha1.PodIntent{}).
 		WithDefaulter(&conventionsv1alpha1.PodIntentDefaulter{}).
 		WithValidator(&conventionsv1alpha1.PodIntentValidator{}).
 		Complete(); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "PodIntent")
-		os.Exit(1)
+		return err
 	}
 
 	if err = ctrl.NewWebhookManagedBy(mgr, &conventionsv1alpha1.ClusterPodConvention{}).
 		WithDefaulter(&conventionsv1alpha1.ClusterPodConventionDefaults{}).
-		WithValidator(&conventionsv1alpha1.ClusterPodConventionValidator{}).
+		WithValidator(&conventionsv1alpha1.ClusterPodConventionValidator{Client: mgr.GetClient()}).
 		Complete(); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "ClusterPodConvention")
-		os.Exit(1)
+		return err
+	}
+
+	if err = ctrl.NewWebhookManagedBy(mgr, &conventionsv1alpha1.ConventionProfile{}).
+		WithValidator(&conventionsv1alpha1.ConventionProfileValidator{}).
+		Complete(); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "ConventionProfile")
+		return err
 	}
 
 	setupLog.Info("starting metrics reconciler")
@@ -160,23 +253,24 @@ func main() {
 		Name:      metricsconfigMapName,
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MetricsReconciler")
-		os.Exit(1)
+		return err
 	}
 
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("default", func(_ *http.Request) error { return nil }); err != nil {
 		setupLog.Error(err, "unable to create health check")
-		os.Exit(1)
+		return err
 	}
 	if err := mgr.AddReadyzCheck("default", func(_ *http.Request) error { return nil }); err != nil {
 		setupLog.Error(err, "unable to create ready check")
-		os.Exit(1)
+		return err
 	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "there was an error starting the manager")
-		os.Exit(1)
+		return err
 	}
+	return nil
 }
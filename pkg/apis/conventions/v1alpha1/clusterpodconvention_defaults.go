@@ -43,13 +43,34 @@ func (s *ClusterPodConventionSpec) Default() error {
 	if s.SelectorTarget == "" {
 		s.SelectorTarget = PodTemplateSpecLabels
 	}
+	if s.Mode == "" {
+		s.Mode = MutateConventionMode
+	}
+	if s.Mutations != nil {
+		s.Mutations.Default()
+	}
 	return nil
 }
 
+func (s *ClusterPodConventionMutations) Default() {
+	if s.Policy == "" {
+		s.Policy = FailMutationPolicy
+	}
+}
+
 func (s *ClusterPodConventionWebhook) Default() {
 	if s.ClientConfig.Service != nil {
 		if s.ClientConfig.Service.Port == nil {
 			s.ClientConfig.Service.Port = utilpointer.Int32Ptr(443)
 		}
 	}
+	if s.ServiceAccountToken != nil {
+		s.ServiceAccountToken.Default()
+	}
+}
+
+func (s *ClusterPodConventionWebhookServiceAccountToken) Default() {
+	if s.ExpirationSeconds == nil {
+		s.ExpirationSeconds = utilpointer.Int64Ptr(3600)
+	}
 }
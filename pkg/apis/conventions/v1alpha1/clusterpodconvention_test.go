@@ -23,8 +23,12 @@ import (
 	"github.com/google/go-cmp/cmp"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilpointer "k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const WrongPriority PriorityLevel = "wrong-level"
@@ -69,6 +73,7 @@ func TestClusterPodConventionDefault(t *testing.T) {
 			Spec: ClusterPodConventionSpec{
 				SelectorTarget: "PodTemplateSpec",
 				Priority:       EarlyPriority,
+				Mode:           MutateConventionMode,
 				Webhook: &ClusterPodConventionWebhook{
 					ClientConfig: admissionregistrationv1.WebhookClientConfig{
 						Service: &admissionregistrationv1.ServiceReference{
@@ -80,6 +85,72 @@ func TestClusterPodConventionDefault(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "with service account token",
+		in: &ClusterPodConvention{
+			Spec: ClusterPodConventionSpec{
+				SelectorTarget: "PodTemplateSpec",
+				Priority:       NormalPriority,
+				Webhook: &ClusterPodConventionWebhook{
+					ClientConfig: validClientConfig,
+					ServiceAccountToken: &ClusterPodConventionWebhookServiceAccountToken{
+						Audience: "my-convention",
+					},
+				},
+			},
+		},
+		want: &ClusterPodConvention{
+			Spec: ClusterPodConventionSpec{
+				SelectorTarget: "PodTemplateSpec",
+				Priority:       NormalPriority,
+				Mode:           MutateConventionMode,
+				Webhook: &ClusterPodConventionWebhook{
+					ClientConfig: validClientConfig,
+					ServiceAccountToken: &ClusterPodConventionWebhookServiceAccountToken{
+						Audience:          "my-convention",
+						ExpirationSeconds: utilpointer.Int64Ptr(3600),
+					},
+				},
+			},
+		},
+	}, {
+		name: "with mutations",
+		in: &ClusterPodConvention{
+			Spec: ClusterPodConventionSpec{
+				SelectorTarget: "PodTemplateSpec",
+				Priority:       NormalPriority,
+				Mutations: &ClusterPodConventionMutations{
+					AllowedScopes: []MutationScope{EnvMutationScope},
+				},
+			},
+		},
+		want: &ClusterPodConvention{
+			Spec: ClusterPodConventionSpec{
+				SelectorTarget: "PodTemplateSpec",
+				Priority:       NormalPriority,
+				Mode:           MutateConventionMode,
+				Mutations: &ClusterPodConventionMutations{
+					AllowedScopes: []MutationScope{EnvMutationScope},
+					Policy:        FailMutationPolicy,
+				},
+			},
+		},
+	}, {
+		name: "with validate mode",
+		in: &ClusterPodConvention{
+			Spec: ClusterPodConventionSpec{
+				SelectorTarget: "PodTemplateSpec",
+				Priority:       NormalPriority,
+				Mode:           ValidateConventionMode,
+			},
+		},
+		want: &ClusterPodConvention{
+			Spec: ClusterPodConventionSpec{
+				SelectorTarget: "PodTemplateSpec",
+				Priority:       NormalPriority,
+				Mode:           ValidateConventionMode,
+			},
+		},
 	}}
 
 	for _, test := range tests {
@@ -275,6 +346,320 @@ func TestClusterPodConventionValidate(t *testing.T) {
 				field.Required(field.NewPath("spec", "webhook", "certificate", "namespace"), ""),
 				field.Required(field.NewPath("spec", "webhook", "certificate", "name"), ""),
 			},
+		}, {
+			name: "with boms",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+						BOMs: &ClusterPodConventionWebhookBOMs{
+							Names:   []string{"cnb-app:/layers/sbom/launch/*/sbom.cdx.json"},
+							Formats: []BOMFormat{CycloneDXBOMFormat, SPDXBOMFormat},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		}, {
+			name: "invalid boms",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+						BOMs: &ClusterPodConventionWebhookBOMs{
+							None:    true,
+							Names:   []string{"cnb-app:[*"},
+							Formats: []BOMFormat{"XML"},
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "webhook", "boms", "none"), "names and formats must not be set when none is true"),
+				field.Invalid(field.NewPath("spec", "webhook", "boms", "names").Index(0), "cnb-app:[*", "syntax error in pattern"),
+				field.NotSupported(field.NewPath("spec", "webhook", "boms", "formats").Index(0), BOMFormat("XML"), []BOMFormat{CycloneDXBOMFormat, SPDXBOMFormat, SyftBOMFormat}),
+			},
+		}, {
+			name: "with service account token",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+						ServiceAccountToken: &ClusterPodConventionWebhookServiceAccountToken{
+							Audience:          "my-convention",
+							ExpirationSeconds: utilpointer.Int64Ptr(3600),
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		}, {
+			name: "invalid service account token",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+						ServiceAccountToken: &ClusterPodConventionWebhookServiceAccountToken{
+							ExpirationSeconds: utilpointer.Int64Ptr(60),
+						},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "webhook", "serviceAccountToken", "audience"), ""),
+				field.Invalid(field.NewPath("spec", "webhook", "serviceAccountToken", "expirationSeconds"), int64(60), "must be at least 600 seconds"),
+			},
+		}, {
+			name: "with mutations",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+					Mutations: &ClusterPodConventionMutations{
+						AllowedScopes: []MutationScope{MetadataMutationScope, EnvMutationScope},
+						Policy:        StripMutationPolicy,
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		}, {
+			name: "invalid mutations",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+					Mutations: &ClusterPodConventionMutations{
+						AllowedScopes: []MutationScope{EnvMutationScope, "Image", EnvMutationScope},
+						Policy:        "Ignore",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.NotSupported(field.NewPath("spec", "mutations", "allowedScopes").Index(1), MutationScope("Image"), []MutationScope{MetadataMutationScope, EnvMutationScope, ProbesMutationScope, ResourcesMutationScope, SecurityContextMutationScope}),
+				field.Duplicate(field.NewPath("spec", "mutations", "allowedScopes").Index(2), EnvMutationScope),
+				field.NotSupported(field.NewPath("spec", "mutations", "policy"), MutationPolicy("Ignore"), []MutationPolicy{FailMutationPolicy, StripMutationPolicy}),
+			},
+		}, {
+			name: "with params",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+					Params: &ClusterPodConventionParams{
+						Values: map[string]string{"jvm.memory-ratio": "0.75"},
+						From: []ClusterPodConventionParamsSource{
+							{ConfigMapRef: &ClusterPodConventionParamsReference{Namespace: "conventions", Name: "defaults"}},
+							{SecretRef: &ClusterPodConventionParamsReference{Namespace: "conventions", Name: "credentials"}},
+						},
+						NamespaceOverrides: "conventions-params",
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		}, {
+			name: "invalid params",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+					Params: &ClusterPodConventionParams{
+						From: []ClusterPodConventionParamsSource{
+							{},
+							{
+								ConfigMapRef: &ClusterPodConventionParamsReference{Namespace: "conventions", Name: "defaults"},
+								SecretRef:    &ClusterPodConventionParamsReference{Namespace: "conventions", Name: "credentials"},
+							},
+							{ConfigMapRef: &ClusterPodConventionParamsReference{}},
+						},
+						NamespaceOverrides: "Conventions_Params",
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "params", "from").Index(0).Child("[configMapRef, secretRef]"), "expected exactly one, got neither"),
+				field.Required(field.NewPath("spec", "params", "from").Index(1).Child("[configMapRef, secretRef]"), "expected exactly one, got both"),
+				field.Required(field.NewPath("spec", "params", "from").Index(2).Child("configMapRef", "namespace"), ""),
+				field.Required(field.NewPath("spec", "params", "from").Index(2).Child("configMapRef", "name"), ""),
+				field.Invalid(field.NewPath("spec", "params", "namespaceOverrides"), "Conventions_Params", validation.IsDNS1123Subdomain("Conventions_Params")[0]),
+			},
+		}, {
+			name: "with resources",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+					Resources: []ClusterPodConventionResource{
+						{APIVersion: "v1", Kind: "LimitRange", Name: "*"},
+						{APIVersion: "v1", Kind: "Secret", Name: "binding-*", MetadataOnly: true},
+						{APIVersion: "v1", Kind: "ConfigMap", Name: "team-defaults"},
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		}, {
+			name: "invalid resources",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+					Resources: []ClusterPodConventionResource{
+						{},
+						{APIVersion: "v1", Kind: "LimitRange", Name: "[petclinic"},
+						{APIVersion: "servicebinding.io/v1beta1", Kind: "ServiceBinding", Name: "petclinic-db"},
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Required(field.NewPath("spec", "resources").Index(0).Child("apiVersion"), ""),
+				field.Required(field.NewPath("spec", "resources").Index(0).Child("kind"), ""),
+				field.Required(field.NewPath("spec", "resources").Index(0).Child("name"), ""),
+				field.Invalid(field.NewPath("spec", "resources").Index(1).Child("name"), "[petclinic", "syntax error in pattern"),
+				field.NotSupported(field.NewPath("spec", "resources").Index(2).Child("apiVersion"), "servicebinding.io/v1beta1", []string{"v1"}),
+				field.NotSupported(field.NewPath("spec", "resources").Index(2).Child("kind"), "ServiceBinding", ResourceKinds),
+			},
+		}, {
+			name: "validate mode",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Mode:           ValidateConventionMode,
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		}, {
+			name: "invalid mode",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Mode:           "Audit",
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.NotSupported(field.NewPath("spec", "mode"), ConventionMode("Audit"), []ConventionMode{MutateConventionMode, ValidateConventionMode}),
+			},
+		}, {
+			name: "validate mode with mutations",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Mode:           ValidateConventionMode,
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+					Mutations: &ClusterPodConventionMutations{
+						AllowedScopes: []MutationScope{EnvMutationScope},
+						Policy:        FailMutationPolicy,
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "mutations"), "must not be set when mode is Validate"),
+			},
+		}, {
+			name: "with ordering",
+			target: &ClusterPodConvention{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "spring-boot",
+				},
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					Order:          10,
+					RunAfter:       []string{"base-image"},
+					RunBefore:      []string{"security-context"},
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		}, {
+			name: "invalid ordering",
+			target: &ClusterPodConvention{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "spring-boot",
+				},
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget: "PodTemplateSpec",
+					Priority:       "Normal",
+					RunAfter:       []string{"Base_Image", "base-image", "base-image", "spring-boot"},
+					RunBefore:      []string{"base-image"},
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "runAfter").Index(0), "Base_Image", validation.IsDNS1123Subdomain("Base_Image")[0]),
+				field.Duplicate(field.NewPath("spec", "runAfter").Index(2), "base-image"),
+				field.Invalid(field.NewPath("spec", "runBefore").Index(0), "base-image", "must not also be in runAfter"),
+				field.Invalid(field.NewPath("spec", "runAfter").Index(3), "spring-boot", "must not reference itself"),
+			},
+		}, {
+			name: "with exclusive fields",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget:  "PodTemplateSpec",
+					Priority:        "Normal",
+					ExclusiveFields: []string{".spec.containers[*].livenessProbe", ".metadata.labels.app.kubernetes.io/*"},
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+				},
+			},
+			expected: field.ErrorList{},
+		}, {
+			name: "invalid exclusive fields",
+			target: &ClusterPodConvention{
+				Spec: ClusterPodConventionSpec{
+					SelectorTarget:  "PodTemplateSpec",
+					Priority:        "Normal",
+					ExclusiveFields: []string{"spec.containers", ".metadata.labels\\", ".spec.containers", ".spec.containers"},
+					Webhook: &ClusterPodConventionWebhook{
+						ClientConfig: validClientConfig,
+					},
+				},
+			},
+			expected: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "exclusiveFields").Index(0), "spec.containers", "must start with '.'"),
+				field.Invalid(field.NewPath("spec", "exclusiveFields").Index(1), ".metadata.labels\\", "syntax error in pattern"),
+				field.Duplicate(field.NewPath("spec", "exclusiveFields").Index(3), ".spec.containers"),
+			},
 		}, {
 			name: "invalid selector target",
 			target: &ClusterPodConvention{
@@ -346,3 +731,102 @@ func TestClusterPodConventionValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterPodConventionValidateOrderingCycle(t *testing.T) {
+	convention := func(name string, runAfter, runBefore []string) *ClusterPodConvention {
+		return &ClusterPodConvention{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: ClusterPodConventionSpec{
+				SelectorTarget: "PodTemplateSpec",
+				Priority:       "Normal",
+				RunAfter:       runAfter,
+				RunBefore:      runBefore,
+				Webhook: &ClusterPodConventionWebhook{
+					ClientConfig: validClientConfig,
+				},
+			},
+		}
+	}
+
+	for _, c := range []struct {
+		name     string
+		given    []client.Object
+		target   *ClusterPodConvention
+		expected field.ErrorList
+	}{{
+		name:     "no existing conventions",
+		target:   convention("b", []string{"a"}, []string{"c"}),
+		expected: field.ErrorList{},
+	}, {
+		name: "ordered after existing conventions",
+		given: []client.Object{
+			convention("a", nil, nil),
+			convention("c", []string{"a"}, nil),
+		},
+		target:   convention("b", []string{"a", "c"}, nil),
+		expected: field.ErrorList{},
+	}, {
+		name: "cycle through runAfter",
+		given: []client.Object{
+			convention("a", []string{"b"}, nil),
+		},
+		target: convention("b", []string{"a"}, nil),
+		expected: field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "[runAfter, runBefore]"), "ordering cycle between ClusterPodConventions: b -> a -> b"),
+		},
+	}, {
+		name: "cycle through runBefore",
+		given: []client.Object{
+			convention("a", nil, []string{"b"}),
+			convention("b", nil, []string{"c"}),
+		},
+		target: convention("c", nil, []string{"a"}),
+		expected: field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "[runAfter, runBefore]"), "ordering cycle between ClusterPodConventions: c -> a -> b -> c"),
+		},
+	}, {
+		name: "cycle through conventions that do not exist",
+		given: []client.Object{
+			convention("a", []string{"missing"}, nil),
+		},
+		target: convention("missing", []string{"a"}, nil),
+		expected: field.ErrorList{
+			field.Forbidden(field.NewPath("spec", "[runAfter, runBefore]"), "ordering cycle between ClusterPodConventions: missing -> a -> missing"),
+		},
+	}, {
+		name: "update removes cycle",
+		given: []client.Object{
+			convention("a", []string{"b"}, nil),
+			convention("b", []string{"a"}, nil),
+		},
+		target:   convention("b", nil, nil),
+		expected: field.ErrorList{},
+	}, {
+		name: "existing cycle not through the convention",
+		given: []client.Object{
+			convention("a", []string{"b"}, nil),
+			convention("b", []string{"a"}, nil),
+		},
+		target:   convention("c", []string{"a"}, nil),
+		expected: field.ErrorList{},
+	}} {
+		t.Run(c.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = AddToScheme(scheme)
+			validator := &ClusterPodConventionValidator{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(c.given...).Build(),
+			}
+
+			_, create := validator.ValidateCreate(context.TODO(), c.target)
+			if diff := cmp.Diff(c.expected.ToAggregate(), create); diff != "" {
+				t.Errorf("ValidateCreate() (-expected, +actual) = %v", diff)
+			}
+			_, update := validator.ValidateUpdate(context.TODO(), nil, c.target)
+			if diff := cmp.Diff(c.expected.ToAggregate(), update); diff != "" {
+				t.Errorf("ValidateUpdate() (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
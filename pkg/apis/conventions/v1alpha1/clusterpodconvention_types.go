@@ -38,6 +38,50 @@ const (
 	PodIntentLabels       SelectorTargetSource = "PodIntent"
 )
 
+type BOMFormat string
+
+const (
+	// CycloneDXBOMFormat matches BOMs named with a `.cdx.json` extension
+	CycloneDXBOMFormat BOMFormat = "CycloneDX"
+	// SPDXBOMFormat matches BOMs named with a `.spdx.json` extension
+	SPDXBOMFormat BOMFormat = "SPDX"
+	// SyftBOMFormat matches BOMs named with a `.syft.json` extension
+	SyftBOMFormat BOMFormat = "Syft"
+)
+
+type MutationScope string
+
+const (
+	// MetadataMutationScope allows changes to the labels and annotations of the pod template
+	MetadataMutationScope MutationScope = "Metadata"
+	// EnvMutationScope allows changes to the env and envFrom of existing containers
+	EnvMutationScope MutationScope = "Env"
+	// ProbesMutationScope allows changes to the liveness, readiness and startup probes of existing containers
+	ProbesMutationScope MutationScope = "Probes"
+	// ResourcesMutationScope allows changes to the resource requirements of existing containers
+	ResourcesMutationScope MutationScope = "Resources"
+	// SecurityContextMutationScope allows changes to the pod and existing containers' security context
+	SecurityContextMutationScope MutationScope = "SecurityContext"
+)
+
+type MutationPolicy string
+
+const (
+	// FailMutationPolicy fails the PodIntent when the convention makes changes outside of the allowed scopes
+	FailMutationPolicy MutationPolicy = "Fail"
+	// StripMutationPolicy discards changes outside of the allowed scopes, keeping the allowed changes
+	StripMutationPolicy MutationPolicy = "Strip"
+)
+
+type ConventionMode string
+
+const (
+	// MutateConventionMode applies the convention to the template
+	MutateConventionMode ConventionMode = "Mutate"
+	// ValidateConventionMode allows or denies the template after all mutating conventions are applied
+	ValidateConventionMode ConventionMode = "Validate"
+)
+
 type ClusterPodConventionSpec struct {
 	// Label selector for workloads.
 	// It must match the workload's pod template's labels.
@@ -46,6 +90,101 @@ type ClusterPodConventionSpec struct {
 	SelectorTarget SelectorTargetSource         `json:"selectorTarget"`
 	Priority       PriorityLevel                `json:"priority,omitempty"`
 	Webhook        *ClusterPodConventionWebhook `json:"webhook,omitempty"`
+	// Mode is either Mutate, to apply the convention to the template, or Validate, to allow or
+	// deny the template after all mutating conventions are applied. Defaults to Mutate.
+	// +optional
+	Mode ConventionMode `json:"mode,omitempty"`
+	// Mutations restricts the changes the convention may make to the pod template. All changes
+	// are allowed when not specified.
+	// +optional
+	Mutations *ClusterPodConventionMutations `json:"mutations,omitempty"`
+	// Params configure the convention, they are sent to the webhook with each request so one
+	// convention server can serve several differently configured conventions.
+	// +optional
+	Params *ClusterPodConventionParams `json:"params,omitempty"`
+	// Resources are read by the controller from the PodIntent's namespace and sent to the
+	// convention with each request, so the convention server does not need access to the cluster.
+	// +optional
+	Resources []ClusterPodConventionResource `json:"resources,omitempty"`
+	// Order sorts conventions within the same priority, lower values are applied first.
+	// Conventions with the same order are applied alphabetically by name. Defaults to 0.
+	// +optional
+	Order int32 `json:"order,omitempty"`
+	// RunAfter are the names of ClusterPodConventions this convention is applied after, when
+	// they apply to the same workload. References take precedence over priority and order.
+	// +optional
+	RunAfter []string `json:"runAfter,omitempty"`
+	// RunBefore are the names of ClusterPodConventions this convention is applied before, when
+	// they apply to the same workload. References take precedence over priority and order.
+	// +optional
+	RunBefore []string `json:"runBefore,omitempty"`
+	// ExclusiveFields are patterns for the fields of the pod template only this convention may
+	// write, like `.spec.containers[*].livenessProbe`. Applying conventions fails when another
+	// convention writes a field matched by the patterns, or a field containing it. Otherwise a
+	// convention overwriting a field written by an earlier convention is reported as a warning.
+	// +optional
+	ExclusiveFields []string `json:"exclusiveFields,omitempty"`
+	// Mandatory conventions are applied to every workload their selectors match. PodIntents may
+	// not exclude mandatory conventions, or the conventions within them.
+	// +optional
+	Mandatory bool `json:"mandatory,omitempty"`
+}
+
+type ClusterPodConventionParams struct {
+	// Values are free-form parameters for the convention. Values take precedence over params
+	// read from ConfigMaps and Secrets.
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
+	// From are ConfigMaps and Secrets whose data is read as params. Later sources take
+	// precedence over earlier sources.
+	// +optional
+	From []ClusterPodConventionParamsSource `json:"from,omitempty"`
+	// NamespaceOverrides is the name of a ConfigMap in the PodIntent's namespace whose data
+	// overrides the params for workloads in that namespace. The ConfigMap is optional.
+	// +optional
+	NamespaceOverrides string `json:"namespaceOverrides,omitempty"`
+}
+
+type ClusterPodConventionParamsSource struct {
+	// ConfigMapRef reads params from the data of a ConfigMap.
+	// +optional
+	ConfigMapRef *ClusterPodConventionParamsReference `json:"configMapRef,omitempty"`
+	// SecretRef reads params from the data of a Secret.
+	// +optional
+	SecretRef *ClusterPodConventionParamsReference `json:"secretRef,omitempty"`
+}
+
+type ClusterPodConventionParamsReference struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// ResourceKinds are the kinds of resources a convention may declare, they are all in the core
+// `v1` API group.
+var ResourceKinds = []string{"ConfigMap", "LimitRange", "Secret"}
+
+type ClusterPodConventionResource struct {
+	// APIVersion of the resources, must be `v1`.
+	APIVersion string `json:"apiVersion"`
+	// Kind of the resources, one of `ConfigMap`, `LimitRange` or `Secret`.
+	Kind string `json:"kind"`
+	// Name is a glob pattern matched against the names of the resources in the PodIntent's
+	// namespace, for example `team-defaults` or `*`.
+	Name string `json:"name"`
+	// MetadataOnly sends only the metadata of the resources. Secrets are always sent as
+	// metadata only.
+	// +optional
+	MetadataOnly bool `json:"metadataOnly,omitempty"`
+}
+
+type ClusterPodConventionMutations struct {
+	// AllowedScopes are the parts of the pod template the convention may change. Containers may
+	// not be added or removed, and their images may not be changed, by a convention with
+	// allowed scopes.
+	AllowedScopes []MutationScope `json:"allowedScopes"`
+	// Policy for changes outside of the allowed scopes, either Fail or Strip. Defaults to Fail.
+	// +optional
+	Policy MutationPolicy `json:"policy,omitempty"`
 }
 
 type ClusterPodConventionWebhook struct {
@@ -53,6 +192,38 @@ type ClusterPodConventionWebhook struct {
 	ClientConfig admissionregistrationv1.WebhookClientConfig `json:"clientConfig"`
 	// Certificate references a cert-manager Certificate resource whose CA should be trusted.
 	Certificate *ClusterPodConventionWebhookCertificate `json:"certificate,omitempty"`
+	// BOMs filters the software bill of materials sent to the convention for each image.
+	// All BOMs are sent when not specified.
+	// +optional
+	BOMs *ClusterPodConventionWebhookBOMs `json:"boms,omitempty"`
+	// ServiceAccountToken sends a token for the controller's service account, scoped to an
+	// audience, as a bearer token with each request. The convention can authenticate the
+	// controller by reviewing the token.
+	// +optional
+	ServiceAccountToken *ClusterPodConventionWebhookServiceAccountToken `json:"serviceAccountToken,omitempty"`
+}
+
+type ClusterPodConventionWebhookBOMs struct {
+	// None excludes all BOMs from the request, for conventions that do not inspect BOMs.
+	// +optional
+	None bool `json:"none,omitempty"`
+	// Names are glob patterns matched against the BOM name, for example
+	// `cnb-app:/layers/sbom/launch/*/sbom.cdx.json`. As with path.Match, `*` does not match `/`.
+	// A BOM is sent if it matches any of the patterns.
+	// +optional
+	Names []string `json:"names,omitempty"`
+	// Formats limits the BOMs to the formats listed, as detected from the BOM name.
+	// +optional
+	Formats []BOMFormat `json:"formats,omitempty"`
+}
+
+type ClusterPodConventionWebhookServiceAccountToken struct {
+	// Audience the token is issued for. The convention must reject tokens for other audiences.
+	Audience string `json:"audience"`
+	// ExpirationSeconds is the requested lifetime of the token, at least ten minutes and one hour
+	// by default. Tokens are renewed before they expire.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
 }
 
 type ClusterPodConventionWebhookCertificate struct {
@@ -60,15 +231,55 @@ type ClusterPodConventionWebhookCertificate struct {
 	Name      string `json:"name"`
 }
 
+type CircuitBreakerState string
+
+const (
+	// ClosedCircuitBreakerState sends requests to the convention
+	ClosedCircuitBreakerState CircuitBreakerState = "Closed"
+	// OpenCircuitBreakerState fails requests to the convention without sending them, after
+	// consecutive failures
+	OpenCircuitBreakerState CircuitBreakerState = "Open"
+	// HalfOpenCircuitBreakerState sends a single trial request to the convention, closing the
+	// circuit if it succeeds
+	HalfOpenCircuitBreakerState CircuitBreakerState = "HalfOpen"
+)
+
+type ClusterPodConventionStatus struct {
+	// CircuitBreaker is the state of the circuit breaker for requests to the convention's webhook.
+	// +optional
+	CircuitBreaker *ClusterPodConventionCircuitBreakerStatus `json:"circuitBreaker,omitempty"`
+}
+
+type ClusterPodConventionCircuitBreakerStatus struct {
+	// State of the circuit breaker, one of Closed, Open or HalfOpen.
+	State CircuitBreakerState `json:"state"`
+	// ConsecutiveFailures is the number of requests to the convention that failed in a row.
+	// +optional
+	ConsecutiveFailures int32 `json:"consecutiveFailures,omitempty"`
+	// LastTransitionTime is the last time the state changed.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// RetryTime is when an open circuit allows a trial request to the convention, or when a
+	// half-open circuit allows another trial if the outcome of the current trial is unknown.
+	// +optional
+	RetryTime *metav1.Time `json:"retryTime,omitempty"`
+	// Message is the error of the last failed request.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:categories="conventions",scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Circuit",type=string,JSONPath=`.status.circuitBreaker.state`
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 type ClusterPodConvention struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ClusterPodConventionSpec `json:"spec"`
+	Spec   ClusterPodConventionSpec   `json:"spec"`
+	Status ClusterPodConventionStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
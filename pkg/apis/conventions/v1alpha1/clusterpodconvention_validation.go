@@ -18,36 +18,117 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	apiserverwebhook "k8s.io/apiserver/pkg/util/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 // +kubebuilder:webhook:path=/validate-conventions-carto-run-v1alpha1-clusterpodconvention,mutating=false,failurePolicy=fail,sideEffects=none,admissionReviewVersions=v1beta1,groups=conventions.carto.run,resources=clusterpodconventions,verbs=create;update,versions=v1alpha1,name=clusterpodconventions.conventions.carto.run
 
-type ClusterPodConventionValidator struct{}
+// +kubebuilder:object:generate=false
+type ClusterPodConventionValidator struct {
+	// Client lists ClusterPodConventions to reject runAfter and runBefore references that
+	// create an ordering cycle between conventions. Cycles are not detected when nil.
+	Client client.Reader
+}
 
 var _ admission.Validator[*ClusterPodConvention] = &ClusterPodConventionValidator{}
 
-func (*ClusterPodConventionValidator) ValidateCreate(ctx context.Context, obj *ClusterPodConvention) (admission.Warnings, error) {
-	return nil, obj.validate().ToAggregate()
+func (v *ClusterPodConventionValidator) ValidateCreate(ctx context.Context, obj *ClusterPodConvention) (admission.Warnings, error) {
+	return v.validate(ctx, obj)
 }
 
-func (*ClusterPodConventionValidator) ValidateUpdate(ctx context.Context, old, obj *ClusterPodConvention) (admission.Warnings, error) {
+func (v *ClusterPodConventionValidator) ValidateUpdate(ctx context.Context, old, obj *ClusterPodConvention) (admission.Warnings, error) {
 	// TODO check for immutable fields
-	return nil, obj.validate().ToAggregate()
+	return v.validate(ctx, obj)
 }
 
 func (*ClusterPodConventionValidator) ValidateDelete(ctx context.Context, obj *ClusterPodConvention) (admission.Warnings, error) {
 	return nil, nil
 }
 
+func (v *ClusterPodConventionValidator) validate(ctx context.Context, obj *ClusterPodConvention) (admission.Warnings, error) {
+	errs := obj.validate()
+	if len(errs) != 0 || v.Client == nil {
+		return nil, errs.ToAggregate()
+	}
+
+	existing := &ClusterPodConventionList{}
+	if err := v.Client.List(ctx, existing); err != nil {
+		return nil, err
+	}
+	if cycle := orderingCycle(obj, existing.Items); cycle != nil {
+		errs = append(errs, field.Forbidden(field.NewPath("spec").Child("[runAfter, runBefore]"),
+			fmt.Sprintf("ordering cycle between ClusterPodConventions: %s", strings.Join(cycle, " -> "))))
+	}
+	return nil, errs.ToAggregate()
+}
+
+// orderingCycle returns the names of the conventions in a cycle of runAfter and runBefore
+// references through the convention, starting and ending with the convention, or nil when the
+// convention may be ordered. Existing cycles that do not include the convention are ignored.
+func orderingCycle(obj *ClusterPodConvention, existing []ClusterPodConvention) []string {
+	next := map[string][]string{}
+	addEdges := func(c *ClusterPodConvention) {
+		for _, name := range c.Spec.RunAfter {
+			next[name] = append(next[name], c.Name)
+		}
+		next[c.Name] = append(next[c.Name], c.Spec.RunBefore...)
+	}
+	for i := range existing {
+		if existing[i].Name != obj.Name {
+			addEdges(&existing[i])
+		}
+	}
+	addEdges(obj)
+	for name := range next {
+		slices.Sort(next[name])
+		next[name] = slices.Compact(next[name])
+	}
+
+	visited := sets.New[string]()
+	var walk func(path []string) []string
+	walk = func(path []string) []string {
+		for _, name := range next[path[len(path)-1]] {
+			if name == obj.Name {
+				return append(path, name)
+			}
+			if visited.Has(name) {
+				continue
+			}
+			visited.Insert(name)
+			if cycle := walk(append(path, name)); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	return walk([]string{obj.Name})
+}
+
 func (r *ClusterPodConvention) validate() field.ErrorList {
 	errs := field.ErrorList{}
 	errs = append(errs, r.Spec.validate(field.NewPath("spec"))...)
+	for i, name := range r.Spec.RunAfter {
+		if name == r.Name {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "runAfter").Index(i), name, "must not reference itself"))
+		}
+	}
+	for i, name := range r.Spec.RunBefore {
+		if name == r.Name {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "runBefore").Index(i), name, "must not reference itself"))
+		}
+	}
 	return errs
 }
 
@@ -78,6 +159,156 @@ func (s *ClusterPodConventionSpec) validate(fldPath *field.Path) field.ErrorList
 		)
 	}
 
+	switch s.Mode {
+	case "", MutateConventionMode:
+	case ValidateConventionMode:
+		if s.Mutations != nil {
+			errs = append(errs, field.Forbidden(fldPath.Child("mutations"), "must not be set when mode is Validate"))
+		}
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("mode"), s.Mode, []ConventionMode{MutateConventionMode, ValidateConventionMode}))
+	}
+
+	errs = append(errs, validateConventionReferences(fldPath.Child("runAfter"), s.RunAfter)...)
+	errs = append(errs, validateConventionReferences(fldPath.Child("runBefore"), s.RunBefore)...)
+	for i, name := range s.RunBefore {
+		if slices.Contains(s.RunAfter, name) {
+			errs = append(errs, field.Invalid(fldPath.Child("runBefore").Index(i), name, "must not also be in runAfter"))
+		}
+	}
+
+	seenFields := sets.New[string]()
+	for i, pattern := range s.ExclusiveFields {
+		fldPath := fldPath.Child("exclusiveFields").Index(i)
+		if !strings.HasPrefix(pattern, ".") {
+			errs = append(errs, field.Invalid(fldPath, pattern, "must start with '.'"))
+		} else if _, err := path.Match(strings.NewReplacer("[", `\[`, "]", `\]`).Replace(pattern), ""); err != nil {
+			errs = append(errs, field.Invalid(fldPath, pattern, err.Error()))
+		}
+		if seenFields.Has(pattern) {
+			errs = append(errs, field.Duplicate(fldPath, pattern))
+		}
+		seenFields.Insert(pattern)
+	}
+
+	errs = append(errs, s.Mutations.validate(fldPath.Child("mutations"))...)
+	errs = append(errs, s.Params.validate(fldPath.Child("params"))...)
+	for i := range s.Resources {
+		errs = append(errs, s.Resources[i].validate(fldPath.Child("resources").Index(i))...)
+	}
+
+	return errs
+}
+
+func validateConventionReferences(fldPath *field.Path, names []string) field.ErrorList {
+	errs := field.ErrorList{}
+
+	seen := sets.New[string]()
+	for i, name := range names {
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			errs = append(errs, field.Invalid(fldPath.Index(i), name, msg))
+		}
+		if seen.Has(name) {
+			errs = append(errs, field.Duplicate(fldPath.Index(i), name))
+		}
+		seen.Insert(name)
+	}
+
+	return errs
+}
+
+func (s *ClusterPodConventionMutations) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if s == nil {
+		return errs
+	}
+	scopes := []MutationScope{MetadataMutationScope, EnvMutationScope, ProbesMutationScope, ResourcesMutationScope, SecurityContextMutationScope}
+	seen := map[MutationScope]bool{}
+	for i, scope := range s.AllowedScopes {
+		switch {
+		case !slices.Contains(scopes, scope):
+			errs = append(errs, field.NotSupported(fldPath.Child("allowedScopes").Index(i), scope, scopes))
+		case seen[scope]:
+			errs = append(errs, field.Duplicate(fldPath.Child("allowedScopes").Index(i), scope))
+		}
+		seen[scope] = true
+	}
+	if s.Policy != FailMutationPolicy && s.Policy != StripMutationPolicy {
+		errs = append(errs, field.NotSupported(fldPath.Child("policy"), s.Policy, []MutationPolicy{FailMutationPolicy, StripMutationPolicy}))
+	}
+
+	return errs
+}
+
+func (s *ClusterPodConventionParams) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if s == nil {
+		return errs
+	}
+	for i := range s.From {
+		errs = append(errs, s.From[i].validate(fldPath.Child("from").Index(i))...)
+	}
+	if s.NamespaceOverrides != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(s.NamespaceOverrides) {
+			errs = append(errs, field.Invalid(fldPath.Child("namespaceOverrides"), s.NamespaceOverrides, msg))
+		}
+	}
+
+	return errs
+}
+
+func (s *ClusterPodConventionParamsSource) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	switch {
+	case s.ConfigMapRef != nil && s.SecretRef != nil:
+		errs = append(errs, field.Required(fldPath.Child("[configMapRef, secretRef]"), "expected exactly one, got both"))
+	case s.ConfigMapRef == nil && s.SecretRef == nil:
+		errs = append(errs, field.Required(fldPath.Child("[configMapRef, secretRef]"), "expected exactly one, got neither"))
+	case s.ConfigMapRef != nil:
+		errs = append(errs, s.ConfigMapRef.validate(fldPath.Child("configMapRef"))...)
+	case s.SecretRef != nil:
+		errs = append(errs, s.SecretRef.validate(fldPath.Child("secretRef"))...)
+	}
+
+	return errs
+}
+
+func (s *ClusterPodConventionParamsReference) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if s.Namespace == "" {
+		errs = append(errs, field.Required(fldPath.Child("namespace"), ""))
+	}
+	if s.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	}
+
+	return errs
+}
+
+func (s *ClusterPodConventionResource) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	// only kinds the controller is permitted to read, and caches, may be declared
+	if s.APIVersion == "" {
+		errs = append(errs, field.Required(fldPath.Child("apiVersion"), ""))
+	} else if s.APIVersion != "v1" {
+		errs = append(errs, field.NotSupported(fldPath.Child("apiVersion"), s.APIVersion, []string{"v1"}))
+	}
+	if s.Kind == "" {
+		errs = append(errs, field.Required(fldPath.Child("kind"), ""))
+	} else if !slices.Contains(ResourceKinds, s.Kind) {
+		errs = append(errs, field.NotSupported(fldPath.Child("kind"), s.Kind, ResourceKinds))
+	}
+	if s.Name == "" {
+		errs = append(errs, field.Required(fldPath.Child("name"), ""))
+	} else if _, err := path.Match(s.Name, ""); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("name"), s.Name, err.Error()))
+	}
+
 	return errs
 }
 
@@ -86,6 +317,47 @@ func (s *ClusterPodConventionWebhook) validate(fldPath *field.Path) field.ErrorL
 
 	errs = append(errs, validateClientConfig(fldPath.Child("clientConfig"), s.ClientConfig)...)
 	errs = append(errs, s.Certificate.validate(fldPath.Child("certificate"))...)
+	errs = append(errs, s.BOMs.validate(fldPath.Child("boms"))...)
+	errs = append(errs, s.ServiceAccountToken.validate(fldPath.Child("serviceAccountToken"))...)
+
+	return errs
+}
+
+func (s *ClusterPodConventionWebhookServiceAccountToken) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if s == nil {
+		return errs
+	}
+	if s.Audience == "" {
+		errs = append(errs, field.Required(fldPath.Child("audience"), ""))
+	}
+	if s.ExpirationSeconds != nil && *s.ExpirationSeconds < 600 {
+		errs = append(errs, field.Invalid(fldPath.Child("expirationSeconds"), *s.ExpirationSeconds, "must be at least 600 seconds"))
+	}
+
+	return errs
+}
+
+func (s *ClusterPodConventionWebhookBOMs) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if s == nil {
+		return errs
+	}
+	if s.None && (len(s.Names) != 0 || len(s.Formats) != 0) {
+		errs = append(errs, field.Forbidden(fldPath.Child("none"), "names and formats must not be set when none is true"))
+	}
+	for i, name := range s.Names {
+		if _, err := path.Match(name, ""); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child("names").Index(i), name, err.Error()))
+		}
+	}
+	for i, format := range s.Formats {
+		if format != CycloneDXBOMFormat && format != SPDXBOMFormat && format != SyftBOMFormat {
+			errs = append(errs, field.NotSupported(fldPath.Child("formats").Index(i), format, []BOMFormat{CycloneDXBOMFormat, SPDXBOMFormat, SyftBOMFormat}))
+		}
+	}
 
 	return errs
 }
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestConventionProfileValidate(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		target    *ConventionProfile
+		validator ConventionProfileValidator
+		expected  field.ErrorList
+	}{{
+		name:   "empty",
+		target: &ConventionProfile{},
+		expected: field.ErrorList{
+			field.Required(field.NewPath("spec", "conventions"), ""),
+		},
+	}, {
+		name: "valid",
+		target: &ConventionProfile{
+			Spec: ConventionProfileSpec{
+				Conventions: []string{"spring-boot", "security"},
+				Params: map[string]string{
+					"jvm.memory-ratio": "0.75",
+				},
+			},
+		},
+		expected: field.ErrorList{},
+	}, {
+		name: "invalid conventions",
+		target: &ConventionProfile{
+			Spec: ConventionProfileSpec{
+				Conventions: []string{"Spring_Boot", "security", "security"},
+			},
+		},
+		expected: field.ErrorList{
+			field.Invalid(field.NewPath("spec", "conventions").Index(0), "Spring_Boot", validation.IsDNS1123Subdomain("Spring_Boot")[0]),
+			field.Duplicate(field.NewPath("spec", "conventions").Index(2), "security"),
+		},
+	}} {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.target.validate()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Validate() (-expected, +actual) = %v", diff)
+			}
+			_, create := c.validator.ValidateCreate(context.TODO(), c.target)
+			if diff := cmp.Diff(c.expected.ToAggregate(), create); diff != "" {
+				t.Errorf("ValidateCreate() (-expected, +actual) = %v", diff)
+			}
+			_, update := c.validator.ValidateUpdate(context.TODO(), nil, c.target)
+			if diff := cmp.Diff(c.expected.ToAggregate(), update); diff != "" {
+				t.Errorf("ValidateUpdate() (-expected, +actual) = %v", diff)
+			}
+			_, deleteValidation := c.validator.ValidateDelete(context.TODO(), c.target)
+			if diff := cmp.Diff(nil, deleteValidation); diff != "" {
+				t.Errorf("ValidateDelete() (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestConventionProfileSpec(t *testing.T) {
+	spec := &ConventionProfileSpec{
+		Conventions: []string{"base-image", "spring-boot", "security"},
+	}
+	for name, expected := range map[string]bool{"base-image": true, "security": true, "tracing": false} {
+		if actual := spec.Includes(name); actual != expected {
+			t.Errorf("Includes(%q) expected %v, got %v", name, expected, actual)
+		}
+	}
+	for name, expected := range map[string][]string{
+		"base-image":  {},
+		"spring-boot": {"base-image"},
+		"security":    {"base-image", "spring-boot"},
+		"tracing":     nil,
+	} {
+		if diff := cmp.Diff(expected, spec.Preceding(name)); diff != "" {
+			t.Errorf("Preceding(%q) (-expected, +actual) = %v", name, diff)
+		}
+	}
+}
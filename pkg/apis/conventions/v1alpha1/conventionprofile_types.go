@@ -0,0 +1,78 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ProfileLabelKey on a namespace names the ConventionProfile applied to the PodIntents in the
+	// namespace, unless a PodIntent names its own profile.
+	ProfileLabelKey = "conventions.carto.run/profile"
+)
+
+type ConventionProfileSpec struct {
+	// Conventions are the names of the ClusterPodConventions in the profile, in the order they
+	// are applied. The conventions are applied to workloads selecting the profile regardless of
+	// their selectors.
+	Conventions []string `json:"conventions"`
+	// Params are shared by the conventions in the profile. They take precedence over the params
+	// of each convention, other than the overrides from the PodIntent's namespace.
+	// +optional
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// Includes returns true when the profile names the ClusterPodConvention.
+func (s *ConventionProfileSpec) Includes(name string) bool {
+	return slices.Contains(s.Conventions, name)
+}
+
+// Preceding returns the ClusterPodConventions named before the convention in the profile, which
+// the convention is applied after.
+func (s *ConventionProfileSpec) Preceding(name string) []string {
+	i := slices.Index(s.Conventions, name)
+	if i == -1 {
+		return nil
+	}
+	return slices.Clone(s.Conventions[:i])
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories="conventions",scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+type ConventionProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ConventionProfileSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+type ConventionProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConventionProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ConventionProfile{}, &ConventionProfileList{})
+}
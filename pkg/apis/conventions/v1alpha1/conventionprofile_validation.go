@@ -0,0 +1,61 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/validate-conventions-carto-run-v1alpha1-conventionprofile,mutating=false,failurePolicy=fail,sideEffects=none,admissionReviewVersions=v1beta1,groups=conventions.carto.run,resources=conventionprofiles,verbs=create;update,versions=v1alpha1,name=conventionprofiles.conventions.carto.run
+
+type ConventionProfileValidator struct{}
+
+var _ admission.Validator[*ConventionProfile] = &ConventionProfileValidator{}
+
+func (*ConventionProfileValidator) ValidateCreate(ctx context.Context, obj *ConventionProfile) (admission.Warnings, error) {
+	return nil, obj.validate().ToAggregate()
+}
+
+func (*ConventionProfileValidator) ValidateUpdate(ctx context.Context, old, obj *ConventionProfile) (admission.Warnings, error) {
+	return nil, obj.validate().ToAggregate()
+}
+
+func (*ConventionProfileValidator) ValidateDelete(ctx context.Context, obj *ConventionProfile) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (r *ConventionProfile) validate() field.ErrorList {
+	errs := field.ErrorList{}
+
+	errs = append(errs, r.Spec.validate(field.NewPath("spec"))...)
+
+	return errs
+}
+
+func (s *ConventionProfileSpec) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if len(s.Conventions) == 0 {
+		errs = append(errs, field.Required(fldPath.Child("conventions"), ""))
+	}
+	errs = append(errs, validateConventionReferences(fldPath.Child("conventions"), s.Conventions)...)
+
+	return errs
+}
@@ -24,6 +24,9 @@ import (
 
 const (
 	AppliedConventionsAnnotationKey = "conventions.carto.run/applied-conventions"
+	// DryRunAnnotationKey set to "true" on a PodIntent reports the result of the conventions at
+	// the status' preview, without publishing the template.
+	DryRunAnnotationKey = "conventions.carto.run/dry-run"
 )
 
 const (
@@ -22,7 +22,9 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"reconciler.io/runtime/apis"
 	rtesting "reconciler.io/runtime/testing"
@@ -74,6 +76,35 @@ func TestPodIntentValidate(t *testing.T) {
 		expected: field.ErrorList{
 			field.Required(field.NewPath("spec", "imagePullSecrets").Index(0).Child("name"), ""),
 		},
+	}, {
+		name: "with conventions",
+		target: &PodIntent{
+			Spec: PodIntentSpec{
+				Conventions: &PodIntentConventions{
+					Include: []string{"spring-boot", "security"},
+					Exclude: []string{"security/run-as-non-root"},
+					Profile: "web-apps",
+				},
+			},
+		},
+		expected: field.ErrorList{},
+	}, {
+		name: "invalid conventions",
+		target: &PodIntent{
+			Spec: PodIntentSpec{
+				Conventions: &PodIntentConventions{
+					Include: []string{"Spring_Boot"},
+					Exclude: []string{"security/", "/run-as-non-root"},
+					Profile: "Web_Apps",
+				},
+			},
+		},
+		expected: field.ErrorList{
+			field.Invalid(field.NewPath("spec", "conventions", "include").Index(0), "Spring_Boot", validation.IsDNS1123Subdomain("Spring_Boot")[0]),
+			field.Invalid(field.NewPath("spec", "conventions", "exclude").Index(0), "security/", "the convention within the ClusterPodConvention must not be empty"),
+			field.Invalid(field.NewPath("spec", "conventions", "exclude").Index(1), "/run-as-non-root", validation.IsDNS1123Subdomain("")[0]),
+			field.Invalid(field.NewPath("spec", "conventions", "profile"), "Web_Apps", validation.IsDNS1123Subdomain("Web_Apps")[0]),
+		},
 	}} {
 		t.Run(c.name, func(t *testing.T) {
 			actual := c.target.validate()
@@ -96,6 +127,126 @@ func TestPodIntentValidate(t *testing.T) {
 	}
 }
 
+func TestPodIntentConventions(t *testing.T) {
+	tests := []struct {
+		name             string
+		conventions      *PodIntentConventions
+		expectedIncludes map[string]bool
+		expectedWithin   map[string][]string
+	}{{
+		name:             "unset",
+		expectedIncludes: map[string]bool{"spring-boot": true, "security": true},
+		expectedWithin:   map[string][]string{"spring-boot": nil, "security": nil},
+	}, {
+		name: "exclude",
+		conventions: &PodIntentConventions{
+			Exclude: []string{"spring-boot", "security/run-as-non-root", "security/read-only-root"},
+		},
+		expectedIncludes: map[string]bool{"spring-boot": false, "security": true},
+		expectedWithin:   map[string][]string{"spring-boot": nil, "security": {"run-as-non-root", "read-only-root"}},
+	}, {
+		name: "include",
+		conventions: &PodIntentConventions{
+			Include: []string{"security"},
+		},
+		expectedIncludes: map[string]bool{"spring-boot": false, "security": true},
+		expectedWithin:   map[string][]string{"spring-boot": nil, "security": nil},
+	}, {
+		name: "include and exclude",
+		conventions: &PodIntentConventions{
+			Include: []string{"spring-boot", "security"},
+			Exclude: []string{"spring-boot"},
+		},
+		expectedIncludes: map[string]bool{"spring-boot": false, "security": true},
+		expectedWithin:   map[string][]string{"spring-boot": nil, "security": nil},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for name, expected := range test.expectedIncludes {
+				if actual := test.conventions.Includes(name); actual != expected {
+					t.Errorf("Includes(%q) expected %v, got %v", name, expected, actual)
+				}
+			}
+			for name, expected := range test.expectedWithin {
+				if diff := cmp.Diff(expected, test.conventions.ExcludedWithin(name)); diff != "" {
+					t.Errorf("ExcludedWithin(%q) (-expected, +actual) = %v", name, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPodIntentIsDryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    bool
+	}{{
+		name:     "unannotated",
+		expected: false,
+	}, {
+		name:        "dry run",
+		annotations: map[string]string{DryRunAnnotationKey: "true"},
+		expected:    true,
+	}, {
+		name:        "not dry run",
+		annotations: map[string]string{DryRunAnnotationKey: "false"},
+		expected:    false,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			intent := &PodIntent{
+				ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations},
+			}
+			if actual := intent.IsDryRun(); actual != test.expected {
+				t.Errorf("IsDryRun() expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPodIntentPreviewPodTemplate(t *testing.T) {
+	tests := []struct {
+		name       string
+		preview    *PodIntentPreview
+		expected   *PodTemplateSpec
+		expectsErr bool
+	}{{
+		name: "nil",
+	}, {
+		name:    "without template",
+		preview: &PodIntentPreview{},
+	}, {
+		name: "template",
+		preview: &PodIntentPreview{
+			Template: &apiextensionsv1.JSON{Raw: []byte(`{"metadata":{"labels":{"app":"petclinic"}},"spec":{"containers":[{"name":"workload","image":"petclinic"}]}}`)},
+		},
+		expected: &PodTemplateSpec{
+			ObjectMeta: ObjectMeta{Labels: map[string]string{"app": "petclinic"}},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "workload", Image: "petclinic"}},
+			},
+		},
+	}, {
+		name: "not a template",
+		preview: &PodIntentPreview{
+			Template: &apiextensionsv1.JSON{Raw: []byte(`[]`)},
+		},
+		expectsErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := test.preview.PodTemplate()
+			if (err != nil) != test.expectsErr {
+				t.Fatalf("PodTemplate() expected error %v, got %v", test.expectsErr, err)
+			}
+			if diff := cmp.Diff(test.expected, actual); diff != "" {
+				t.Errorf("PodTemplate() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestPodIntentConditions(t *testing.T) {
 	for _, c := range []struct {
 		name     string
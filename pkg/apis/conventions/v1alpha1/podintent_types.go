@@ -17,7 +17,12 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
+	"slices"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"reconciler.io/runtime/apis"
 )
@@ -34,11 +39,118 @@ type PodIntentSpec struct {
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
 	// Template defines the workload pod temple
 	Template PodTemplateSpec `json:"template"`
+	// Conventions narrows the ClusterPodConventions applied to the workload, beyond their
+	// selectors. Mandatory conventions are always applied when their selectors match.
+	// +optional
+	Conventions *PodIntentConventions `json:"conventions,omitempty"`
+}
+
+type PodIntentConventions struct {
+	// Include, when not empty, are the names of the only ClusterPodConventions applied to the
+	// workload, along with mandatory conventions.
+	// +optional
+	Include []string `json:"include,omitempty"`
+	// Exclude are the names of ClusterPodConventions not applied to the workload, or of
+	// conventions within a ClusterPodConvention as `<cluster-pod-convention>/<convention>`, which
+	// are sent to the ClusterPodConvention to skip.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+	// Profile is the name of the ConventionProfile applied to the workload, in place of the
+	// profile selected by the PodIntent's namespace.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+}
+
+// Includes returns true when the ClusterPodConvention may be applied, that is the include list
+// is empty or names the convention, and the exclude list does not name the convention.
+func (c *PodIntentConventions) Includes(name string) bool {
+	if c == nil {
+		return true
+	}
+	if len(c.Include) != 0 && !slices.Contains(c.Include, name) {
+		return false
+	}
+	return !slices.Contains(c.Exclude, name)
+}
+
+// ExcludedWithin returns the conventions within the ClusterPodConvention that are excluded.
+func (c *PodIntentConventions) ExcludedWithin(name string) []string {
+	if c == nil {
+		return nil
+	}
+	var excluded []string
+	for _, exclude := range c.Exclude {
+		if id, ok := strings.CutPrefix(exclude, name+"/"); ok {
+			excluded = append(excluded, id)
+		}
+	}
+	return excluded
 }
 
 type PodIntentStatus struct {
 	apis.Status `json:",inline"`
-	Template    *PodTemplateSpec `json:"template,omitempty"`
+	// Template is the pod template resulting from the conventions, published when the Ready
+	// condition is True. The template is not cleared while the condition is False or Unknown,
+	// consumers must check the condition before reading it.
+	Template *PodTemplateSpec `json:"template,omitempty"`
+	// Warnings reported by conventions the last time they were applied. Warnings do not
+	// prevent conventions from being applied.
+	// +optional
+	Warnings []PodIntentWarning `json:"warnings,omitempty"`
+	// Preview of the conventions applied to the PodIntent while it is annotated for a dry run.
+	// The preview is not published, consumers should only read the status' template.
+	// +optional
+	Preview *PodIntentPreview `json:"preview,omitempty"`
+}
+
+type PodIntentWarning struct {
+	// Convention is the name of the ClusterPodConvention that reported the warning.
+	Convention string `json:"convention"`
+	// Message is the warning, for the developer of the workload.
+	Message string `json:"message"`
+}
+
+type PodIntentPreview struct {
+	// Template is the pod template resulting from the conventions. The template is not validated,
+	// so the PodIntent's schema does not repeat the PodTemplateSpec, read it with PodTemplate.
+	// +optional
+	Template *apiextensionsv1.JSON `json:"template,omitempty"`
+	// Conventions are the changes made by each convention, in the order they were applied.
+	// +optional
+	Conventions []PodIntentPreviewConvention `json:"conventions,omitempty"`
+}
+
+// PodTemplate returns the previewed template, if any.
+func (p *PodIntentPreview) PodTemplate() (*PodTemplateSpec, error) {
+	if p == nil || p.Template == nil {
+		return nil, nil
+	}
+	template := &PodTemplateSpec{}
+	if err := json.Unmarshal(p.Template.Raw, template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+type PodIntentPreviewConvention struct {
+	// Name of the ClusterPodConvention.
+	Name string `json:"name"`
+	// Changes are the fields of the pod template changed by the convention.
+	// +optional
+	Changes []PodIntentPreviewChange `json:"changes,omitempty"`
+}
+
+type PodIntentPreviewChange struct {
+	// Field of the pod template, like `.spec.containers[workload].env`.
+	Field string `json:"field"`
+	// Before is the value of the field before the convention was applied, unset when the
+	// convention added the field.
+	// +optional
+	Before *apiextensionsv1.JSON `json:"before,omitempty"`
+	// After is the value of the field after the convention was applied, unset when the
+	// convention removed the field.
+	// +optional
+	After *apiextensionsv1.JSON `json:"after,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -57,6 +169,12 @@ type PodIntent struct {
 	Status PodIntentStatus `json:"status"`
 }
 
+// IsDryRun returns true when the PodIntent is annotated to preview the conventions, rather than
+// publish the resulting template.
+func (s *PodIntent) IsDryRun() bool {
+	return s.Annotations[DryRunAnnotationKey] == "true"
+}
+
 // +kubebuilder:object:root=true
 
 type PodIntentList struct {
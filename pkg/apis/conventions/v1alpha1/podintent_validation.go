@@ -18,7 +18,9 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -58,7 +60,37 @@ func (s *PodIntentSpec) validate(fldPath *field.Path) field.ErrorList {
 			errs = append(errs, field.Required(fldPath.Child("imagePullSecrets").Index(index).Child("name"), ""))
 		}
 	}
+	errs = append(errs, s.Conventions.validate(fldPath.Child("conventions"))...)
 	// TODO
 
 	return errs
 }
+
+func (s *PodIntentConventions) validate(fldPath *field.Path) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if s == nil {
+		return errs
+	}
+	for i, name := range s.Include {
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			errs = append(errs, field.Invalid(fldPath.Child("include").Index(i), name, msg))
+		}
+	}
+	for i, exclude := range s.Exclude {
+		name, id, within := strings.Cut(exclude, "/")
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			errs = append(errs, field.Invalid(fldPath.Child("exclude").Index(i), exclude, msg))
+		}
+		if within && id == "" {
+			errs = append(errs, field.Invalid(fldPath.Child("exclude").Index(i), exclude, "the convention within the ClusterPodConvention must not be empty"))
+		}
+	}
+	if s.Profile != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(s.Profile) {
+			errs = append(errs, field.Invalid(fldPath.Child("profile"), s.Profile, msg))
+		}
+	}
+
+	return errs
+}
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -32,6 +33,7 @@ func (in *ClusterPodConvention) DeepCopyInto(out *ClusterPodConvention) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConvention.
@@ -52,6 +54,29 @@ func (in *ClusterPodConvention) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodConventionCircuitBreakerStatus) DeepCopyInto(out *ClusterPodConventionCircuitBreakerStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RetryTime != nil {
+		in, out := &in.RetryTime, &out.RetryTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionCircuitBreakerStatus.
+func (in *ClusterPodConventionCircuitBreakerStatus) DeepCopy() *ClusterPodConventionCircuitBreakerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodConventionCircuitBreakerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterPodConventionDefaults) DeepCopyInto(out *ClusterPodConventionDefaults) {
 	*out = *in
@@ -99,6 +124,110 @@ func (in *ClusterPodConventionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodConventionMutations) DeepCopyInto(out *ClusterPodConventionMutations) {
+	*out = *in
+	if in.AllowedScopes != nil {
+		in, out := &in.AllowedScopes, &out.AllowedScopes
+		*out = make([]MutationScope, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionMutations.
+func (in *ClusterPodConventionMutations) DeepCopy() *ClusterPodConventionMutations {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodConventionMutations)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodConventionParams) DeepCopyInto(out *ClusterPodConventionParams) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]ClusterPodConventionParamsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionParams.
+func (in *ClusterPodConventionParams) DeepCopy() *ClusterPodConventionParams {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodConventionParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodConventionParamsReference) DeepCopyInto(out *ClusterPodConventionParamsReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionParamsReference.
+func (in *ClusterPodConventionParamsReference) DeepCopy() *ClusterPodConventionParamsReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodConventionParamsReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodConventionParamsSource) DeepCopyInto(out *ClusterPodConventionParamsSource) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(ClusterPodConventionParamsReference)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(ClusterPodConventionParamsReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionParamsSource.
+func (in *ClusterPodConventionParamsSource) DeepCopy() *ClusterPodConventionParamsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodConventionParamsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodConventionResource) DeepCopyInto(out *ClusterPodConventionResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionResource.
+func (in *ClusterPodConventionResource) DeepCopy() *ClusterPodConventionResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodConventionResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterPodConventionSpec) DeepCopyInto(out *ClusterPodConventionSpec) {
 	*out = *in
@@ -114,6 +243,36 @@ func (in *ClusterPodConventionSpec) DeepCopyInto(out *ClusterPodConventionSpec)
 		*out = new(ClusterPodConventionWebhook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Mutations != nil {
+		in, out := &in.Mutations, &out.Mutations
+		*out = new(ClusterPodConventionMutations)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = new(ClusterPodConventionParams)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ClusterPodConventionResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.RunAfter != nil {
+		in, out := &in.RunAfter, &out.RunAfter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RunBefore != nil {
+		in, out := &in.RunBefore, &out.RunBefore
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExclusiveFields != nil {
+		in, out := &in.ExclusiveFields, &out.ExclusiveFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionSpec.
@@ -127,16 +286,21 @@ func (in *ClusterPodConventionSpec) DeepCopy() *ClusterPodConventionSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterPodConventionValidator) DeepCopyInto(out *ClusterPodConventionValidator) {
+func (in *ClusterPodConventionStatus) DeepCopyInto(out *ClusterPodConventionStatus) {
 	*out = *in
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(ClusterPodConventionCircuitBreakerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionValidator.
-func (in *ClusterPodConventionValidator) DeepCopy() *ClusterPodConventionValidator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionStatus.
+func (in *ClusterPodConventionStatus) DeepCopy() *ClusterPodConventionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterPodConventionValidator)
+	out := new(ClusterPodConventionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -150,6 +314,16 @@ func (in *ClusterPodConventionWebhook) DeepCopyInto(out *ClusterPodConventionWeb
 		*out = new(ClusterPodConventionWebhookCertificate)
 		**out = **in
 	}
+	if in.BOMs != nil {
+		in, out := &in.BOMs, &out.BOMs
+		*out = new(ClusterPodConventionWebhookBOMs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountToken != nil {
+		in, out := &in.ServiceAccountToken, &out.ServiceAccountToken
+		*out = new(ClusterPodConventionWebhookServiceAccountToken)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionWebhook.
@@ -162,6 +336,31 @@ func (in *ClusterPodConventionWebhook) DeepCopy() *ClusterPodConventionWebhook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodConventionWebhookBOMs) DeepCopyInto(out *ClusterPodConventionWebhookBOMs) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Formats != nil {
+		in, out := &in.Formats, &out.Formats
+		*out = make([]BOMFormat, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionWebhookBOMs.
+func (in *ClusterPodConventionWebhookBOMs) DeepCopy() *ClusterPodConventionWebhookBOMs {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodConventionWebhookBOMs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterPodConventionWebhookCertificate) DeepCopyInto(out *ClusterPodConventionWebhookCertificate) {
 	*out = *in
@@ -177,6 +376,126 @@ func (in *ClusterPodConventionWebhookCertificate) DeepCopy() *ClusterPodConventi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodConventionWebhookServiceAccountToken) DeepCopyInto(out *ClusterPodConventionWebhookServiceAccountToken) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodConventionWebhookServiceAccountToken.
+func (in *ClusterPodConventionWebhookServiceAccountToken) DeepCopy() *ClusterPodConventionWebhookServiceAccountToken {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodConventionWebhookServiceAccountToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConventionProfile) DeepCopyInto(out *ConventionProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConventionProfile.
+func (in *ConventionProfile) DeepCopy() *ConventionProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ConventionProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConventionProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConventionProfileList) DeepCopyInto(out *ConventionProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConventionProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConventionProfileList.
+func (in *ConventionProfileList) DeepCopy() *ConventionProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConventionProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConventionProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConventionProfileSpec) DeepCopyInto(out *ConventionProfileSpec) {
+	*out = *in
+	if in.Conventions != nil {
+		in, out := &in.Conventions, &out.Conventions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConventionProfileSpec.
+func (in *ConventionProfileSpec) DeepCopy() *ConventionProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConventionProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConventionProfileValidator) DeepCopyInto(out *ConventionProfileValidator) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConventionProfileValidator.
+func (in *ConventionProfileValidator) DeepCopy() *ConventionProfileValidator {
+	if in == nil {
+		return nil
+	}
+	out := new(ConventionProfileValidator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectMeta) DeepCopyInto(out *ObjectMeta) {
 	*out = *in
@@ -233,6 +552,31 @@ func (in *PodIntent) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIntentConventions) DeepCopyInto(out *PodIntentConventions) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIntentConventions.
+func (in *PodIntentConventions) DeepCopy() *PodIntentConventions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIntentConventions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodIntentDefaulter) DeepCopyInto(out *PodIntentDefaulter) {
 	*out = *in
@@ -280,6 +624,80 @@ func (in *PodIntentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIntentPreview) DeepCopyInto(out *PodIntentPreview) {
+	*out = *in
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conventions != nil {
+		in, out := &in.Conventions, &out.Conventions
+		*out = make([]PodIntentPreviewConvention, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIntentPreview.
+func (in *PodIntentPreview) DeepCopy() *PodIntentPreview {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIntentPreview)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIntentPreviewChange) DeepCopyInto(out *PodIntentPreviewChange) {
+	*out = *in
+	if in.Before != nil {
+		in, out := &in.Before, &out.Before
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.After != nil {
+		in, out := &in.After, &out.After
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIntentPreviewChange.
+func (in *PodIntentPreviewChange) DeepCopy() *PodIntentPreviewChange {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIntentPreviewChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIntentPreviewConvention) DeepCopyInto(out *PodIntentPreviewConvention) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]PodIntentPreviewChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIntentPreviewConvention.
+func (in *PodIntentPreviewConvention) DeepCopy() *PodIntentPreviewConvention {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIntentPreviewConvention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodIntentSpec) DeepCopyInto(out *PodIntentSpec) {
 	*out = *in
@@ -289,6 +707,11 @@ func (in *PodIntentSpec) DeepCopyInto(out *PodIntentSpec) {
 		copy(*out, *in)
 	}
 	in.Template.DeepCopyInto(&out.Template)
+	if in.Conventions != nil {
+		in, out := &in.Conventions, &out.Conventions
+		*out = new(PodIntentConventions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIntentSpec.
@@ -310,6 +733,16 @@ func (in *PodIntentStatus) DeepCopyInto(out *PodIntentStatus) {
 		*out = new(PodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Warnings != nil {
+		in, out := &in.Warnings, &out.Warnings
+		*out = make([]PodIntentWarning, len(*in))
+		copy(*out, *in)
+	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(PodIntentPreview)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIntentStatus.
@@ -337,6 +770,21 @@ func (in *PodIntentValidator) DeepCopy() *PodIntentValidator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIntentWarning) DeepCopyInto(out *PodIntentWarning) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIntentWarning.
+func (in *PodIntentWarning) DeepCopy() *PodIntentWarning {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIntentWarning)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodTemplateSpec) DeepCopyInto(out *PodTemplateSpec) {
 	*out = *in
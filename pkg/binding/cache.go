@@ -0,0 +1,386 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/cache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// BoundedCacheOptions configures the limits for a BoundedCache.
+type BoundedCacheOptions struct {
+	// MaxBytes is the maximum size of all layers stored in the cache. Least recently used layers
+	// are evicted once the limit is exceeded. Zero disables the size limit.
+	MaxBytes int64
+	// MaxAge is the maximum duration a layer is retained after it was last used. Zero disables
+	// age based eviction.
+	MaxAge time.Duration
+	// GCInterval is the period between age based garbage collection sweeps when the cache is
+	// started as a manager runnable. Defaults to ten minutes.
+	GCInterval time.Duration
+}
+
+// CacheStats is a point in time snapshot of the cache counters.
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+	Bytes     int64
+	Entries   int64
+}
+
+// BoundedCache is a filesystem backed cache.Cache for image layers that evicts the least recently
+// used layers when the cache grows beyond its size limit and removes layers that have not been
+// used within the max age.
+type BoundedCache struct {
+	path  string
+	opts  BoundedCacheOptions
+	inner cache.Cache
+	now   func() time.Time
+
+	m       sync.Mutex
+	entries map[v1.Hash]*list.Element
+	lru     *list.List
+	size    int64
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	evictions atomic.Int64
+}
+
+type cacheEntry struct {
+	hash     v1.Hash
+	size     int64
+	lastUsed time.Time
+}
+
+var _ cache.Cache = (*BoundedCache)(nil)
+
+// NewBoundedCache creates a cache rooted at path. Layers already on disk are scanned so that
+// entries from a previous run count towards the limits, and are evicted if the limits are
+// already exceeded.
+func NewBoundedCache(path string, opts BoundedCacheOptions) (*BoundedCache, error) {
+	if opts.GCInterval == 0 {
+		opts.GCInterval = 10 * time.Minute
+	}
+	c := &BoundedCache{
+		path:    path,
+		opts:    opts,
+		inner:   cache.NewFilesystemCache(path),
+		now:     time.Now,
+		entries: map[v1.Hash]*list.Element{},
+		lru:     list.New(),
+	}
+	if err := c.scan(); err != nil {
+		return nil, err
+	}
+	c.m.Lock()
+	evicted := c.evict()
+	c.m.Unlock()
+	c.deleteLayers(evicted)
+	return c, nil
+}
+
+// scan seeds the lru with the layers already present on disk, using the modification time as
+// the last use.
+func (c *BoundedCache) scan() error {
+	files, err := os.ReadDir(c.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	found := []cacheEntry{}
+	for _, file := range files {
+		if !file.Type().IsRegular() {
+			continue
+		}
+		hash, err := v1.NewHash(file.Name())
+		if err != nil {
+			// not a layer written by the cache
+			continue
+		}
+		info, err := file.Info()
+		if err != nil {
+			return err
+		}
+		found = append(found, cacheEntry{hash: hash, size: info.Size(), lastUsed: info.ModTime()})
+	}
+	// oldest entries at the back of the list
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].lastUsed.After(found[j].lastUsed)
+	})
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	for i := range found {
+		entry := found[i]
+		c.entries[entry.hash] = c.lru.PushBack(&entry)
+		c.size += entry.size
+	}
+	return nil
+}
+
+func (c *BoundedCache) Put(l v1.Layer) (v1.Layer, error) {
+	digest, err := l.Digest()
+	if err != nil {
+		return nil, err
+	}
+	diffID, err := l.DiffID()
+	if err != nil {
+		return nil, err
+	}
+	cached, err := c.inner.Put(l)
+	if err != nil {
+		return nil, err
+	}
+	return &boundedLayer{Layer: cached, c: c, digest: digest, diffID: diffID}, nil
+}
+
+func (c *BoundedCache) Get(h v1.Hash) (v1.Layer, error) {
+	c.m.Lock()
+	var expired []v1.Hash
+	if e, ok := c.entries[h]; ok && c.expired(e.Value.(*cacheEntry)) {
+		expired = append(expired, c.forget(e).hash)
+		c.evictions.Add(1)
+	}
+	c.m.Unlock()
+	c.deleteLayers(expired)
+
+	l, err := c.inner.Get(h)
+	if err != nil {
+		if errors.Is(err, cache.ErrNotFound) {
+			c.misses.Add(1)
+			c.m.Lock()
+			if e, ok := c.entries[h]; ok {
+				// removed out from under us, or was incomplete
+				c.forget(e)
+			}
+			c.m.Unlock()
+		}
+		return nil, err
+	}
+	c.hits.Add(1)
+	c.record(h)
+	return l, nil
+}
+
+func (c *BoundedCache) Delete(h v1.Hash) error {
+	c.m.Lock()
+	if e, ok := c.entries[h]; ok {
+		c.forget(e)
+	}
+	c.m.Unlock()
+	return c.inner.Delete(h)
+}
+
+// Stats returns the current counters for the cache.
+func (c *BoundedCache) Stats() CacheStats {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return CacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Bytes:     c.size,
+		Entries:   int64(c.lru.Len()),
+	}
+}
+
+// Start periodically removes expired layers until the context is canceled. It allows the cache
+// to be added to a controller-runtime manager as a runnable.
+func (c *BoundedCache) Start(ctx context.Context) error {
+	log := logr.FromContextOrDiscard(ctx).WithName("BoundedCache")
+	ticker := time.NewTicker(c.opts.GCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.m.Lock()
+			evicted := c.evict()
+			stats := CacheStats{Bytes: c.size, Entries: int64(c.lru.Len()), Evictions: int64(len(evicted))}
+			c.m.Unlock()
+			c.deleteLayers(evicted)
+			log.V(1).Info("cache garbage collected", "bytes", stats.Bytes, "entries", stats.Entries, "evicted", stats.Evictions)
+		}
+	}
+}
+
+// NeedLeaderElection is false as every replica maintains its own cache.
+func (c *BoundedCache) NeedLeaderElection() bool {
+	return false
+}
+
+// record marks the layer as most recently used, picking up its current size on disk.
+func (c *BoundedCache) record(h v1.Hash) {
+	info, err := os.Stat(filepath.Join(c.path, h.String()))
+	if err != nil {
+		// the layer was not fully written to disk
+		return
+	}
+
+	c.m.Lock()
+	if e, ok := c.entries[h]; ok {
+		entry := e.Value.(*cacheEntry)
+		c.size += info.Size() - entry.size
+		entry.size = info.Size()
+		entry.lastUsed = c.now()
+		c.lru.MoveToFront(e)
+	} else {
+		c.entries[h] = c.lru.PushFront(&cacheEntry{hash: h, size: info.Size(), lastUsed: c.now()})
+		c.size += info.Size()
+	}
+	evicted := c.evict()
+	c.m.Unlock()
+	c.deleteLayers(evicted)
+}
+
+// evict stops tracking expired layers and then the least recently used layers until the cache
+// is within its size limit, returning the layers to delete. The caller must hold the lock, and
+// delete the layers once the lock is released so lookups are not blocked on the disk.
+func (c *BoundedCache) evict() []v1.Hash {
+	var evicted []v1.Hash
+	for e := c.lru.Back(); e != nil; {
+		prev := e.Prev()
+		if c.expired(e.Value.(*cacheEntry)) {
+			evicted = append(evicted, c.forget(e).hash)
+		}
+		e = prev
+	}
+	if c.opts.MaxBytes > 0 {
+		// always retain the most recently used layer, even if it alone exceeds the limit
+		for c.size > c.opts.MaxBytes && c.lru.Len() > 1 {
+			evicted = append(evicted, c.forget(c.lru.Back()).hash)
+		}
+	}
+	c.evictions.Add(int64(len(evicted)))
+	return evicted
+}
+
+func (c *BoundedCache) expired(entry *cacheEntry) bool {
+	return c.opts.MaxAge > 0 && c.now().Sub(entry.lastUsed) > c.opts.MaxAge
+}
+
+// deleteLayers removes evicted layers from disk. The caller must not hold the lock. A layer
+// cached again before it is deleted is removed with it, and is a miss the next time it is used.
+func (c *BoundedCache) deleteLayers(hashes []v1.Hash) {
+	for _, h := range hashes {
+		_ = c.inner.Delete(h)
+	}
+}
+
+// forget stops tracking the layer without touching the disk. The caller must hold the lock.
+func (c *BoundedCache) forget(e *list.Element) *cacheEntry {
+	entry := c.lru.Remove(e).(*cacheEntry)
+	delete(c.entries, entry.hash)
+	c.size -= entry.size
+	return entry
+}
+
+// boundedLayer records the cached files once a reader fully populates them.
+type boundedLayer struct {
+	v1.Layer
+	c              *BoundedCache
+	digest, diffID v1.Hash
+}
+
+func (l *boundedLayer) Compressed() (io.ReadCloser, error) {
+	rc, err := l.Layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	return &recordingReadCloser{ReadCloser: rc, record: func() { l.c.record(l.digest) }}, nil
+}
+
+func (l *boundedLayer) Uncompressed() (io.ReadCloser, error) {
+	rc, err := l.Layer.Uncompressed()
+	if err != nil {
+		return nil, err
+	}
+	return &recordingReadCloser{ReadCloser: rc, record: func() { l.c.record(l.diffID) }}, nil
+}
+
+type recordingReadCloser struct {
+	io.ReadCloser
+	record func()
+}
+
+func (rc *recordingReadCloser) Close() error {
+	// close first so the file is flushed before its size is read
+	err := rc.ReadCloser.Close()
+	if err == nil {
+		rc.record()
+	}
+	return err
+}
+
+// cacheCollector exposes the counters of a BoundedCache.
+type cacheCollector struct {
+	cache *BoundedCache
+
+	hits      *prometheus.Desc
+	misses    *prometheus.Desc
+	evictions *prometheus.Desc
+	bytes     *prometheus.Desc
+	entries   *prometheus.Desc
+}
+
+// NewCacheCollector creates a prometheus collector reporting the hits, misses, evictions and
+// size of the cache. The hit rate is derived from the hits and misses.
+func NewCacheCollector(c *BoundedCache) prometheus.Collector {
+	return &cacheCollector{
+		cache:     c,
+		hits:      prometheus.NewDesc(metricsNamespace+"_image_cache_hits_total", "Number of image layers served from the cache.", nil, nil),
+		misses:    prometheus.NewDesc(metricsNamespace+"_image_cache_misses_total", "Number of image layers not found in the cache.", nil, nil),
+		evictions: prometheus.NewDesc(metricsNamespace+"_image_cache_evictions_total", "Number of image layers evicted from the cache.", nil, nil),
+		bytes:     prometheus.NewDesc(metricsNamespace+"_image_cache_bytes", "Size of the image layers in the cache.", nil, nil),
+		entries:   prometheus.NewDesc(metricsNamespace+"_image_cache_entries", "Number of image layers in the cache.", nil, nil),
+	}
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.evictions
+	ch <- c.bytes
+	ch <- c.entries
+}
+
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.cache.Stats()
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(c.evictions, prometheus.CounterValue, float64(stats.Evictions))
+	ch <- prometheus.MustNewConstMetric(c.bytes, prometheus.GaugeValue, float64(stats.Bytes))
+	ch <- prometheus.MustNewConstMetric(c.entries, prometheus.GaugeValue, float64(stats.Entries))
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding_test
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/cache"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+)
+
+func consumeLayer(t *testing.T, l v1.Layer) {
+	t.Helper()
+	rc, err := l.Uncompressed()
+	if err != nil {
+		t.Fatalf("unable to read layer: %v", err)
+	}
+	if _, err := io.Copy(io.Discard, rc); err != nil {
+		t.Fatalf("unable to read layer: %v", err)
+	}
+	if err := rc.Close(); err != nil {
+		t.Fatalf("unable to close layer: %v", err)
+	}
+}
+
+func putLayer(t *testing.T, c cache.Cache, size int64) v1.Hash {
+	t.Helper()
+	layer, err := random.Layer(size, "application/vnd.oci.image.layer.v1.tar")
+	if err != nil {
+		t.Fatalf("unable to create layer: %v", err)
+	}
+	cached, err := c.Put(layer)
+	if err != nil {
+		t.Fatalf("unable to put layer: %v", err)
+	}
+	consumeLayer(t, cached)
+	diffID, err := layer.DiffID()
+	if err != nil {
+		t.Fatalf("unable to get diffID: %v", err)
+	}
+	return diffID
+}
+
+func TestBoundedCache(t *testing.T) {
+	dir := t.TempDir()
+	c, err := binding.NewBoundedCache(dir, binding.BoundedCacheOptions{MaxBytes: 6000})
+	if err != nil {
+		t.Fatalf("unable to create cache: %v", err)
+	}
+
+	first := putLayer(t, c, 1024)
+	second := putLayer(t, c, 1024)
+	if _, err := c.Get(first); err != nil {
+		t.Errorf("expected first layer to be cached: %v", err)
+	}
+	// exceeds the limit, second layer is least recently used
+	third := putLayer(t, c, 1024)
+
+	if _, err := c.Get(second); !errors.Is(err, cache.ErrNotFound) {
+		t.Errorf("expected second layer to be evicted, got %v", err)
+	}
+	if _, err := c.Get(first); err != nil {
+		t.Errorf("expected first layer to be cached: %v", err)
+	}
+	if _, err := c.Get(third); err != nil {
+		t.Errorf("expected third layer to be cached: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, second.String())); !os.IsNotExist(err) {
+		t.Errorf("expected second layer to be removed from disk, got %v", err)
+	}
+
+	stats := c.Stats()
+	stats.Bytes = 0
+	expected := binding.CacheStats{Hits: 3, Misses: 1, Evictions: 1, Entries: 2}
+	if diff := cmp.Diff(expected, stats); diff != "" {
+		t.Errorf("Stats() (-expected, +actual) = %v", diff)
+	}
+
+	if err := c.Delete(first); err != nil {
+		t.Errorf("unexpected error deleting layer: %v", err)
+	}
+	if entries := c.Stats().Entries; entries != 1 {
+		t.Errorf("expected 1 entry after delete, got %d", entries)
+	}
+}
+
+func TestBoundedCacheStartupScan(t *testing.T) {
+	dir := t.TempDir()
+	seed, err := binding.NewBoundedCache(dir, binding.BoundedCacheOptions{})
+	if err != nil {
+		t.Fatalf("unable to create cache: %v", err)
+	}
+	stale := putLayer(t, seed, 1024)
+	older := putLayer(t, seed, 1024)
+	newer := putLayer(t, seed, 1024)
+	// not a cached layer, must be ignored
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("hello"), 0600); err != nil {
+		t.Fatalf("unable to write file: %v", err)
+	}
+
+	now := time.Now()
+	for hash, age := range map[v1.Hash]time.Duration{
+		stale: 48 * time.Hour,
+		older: 2 * time.Hour,
+		newer: time.Hour,
+	} {
+		mtime := now.Add(-age)
+		if err := os.Chtimes(filepath.Join(dir, hash.String()), mtime, mtime); err != nil {
+			t.Fatalf("unable to set mtime: %v", err)
+		}
+	}
+
+	c, err := binding.NewBoundedCache(dir, binding.BoundedCacheOptions{
+		MaxBytes: 3000,
+		MaxAge:   24 * time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("unable to create cache: %v", err)
+	}
+	if stats := c.Stats(); stats.Entries != 1 || stats.Evictions != 2 {
+		t.Errorf("expected 1 entry and 2 evictions, got %+v", stats)
+	}
+	for _, hash := range []v1.Hash{stale, older} {
+		if _, err := c.Get(hash); !errors.Is(err, cache.ErrNotFound) {
+			t.Errorf("expected layer %s to be evicted, got %v", hash, err)
+		}
+	}
+	if _, err := c.Get(newer); err != nil {
+		t.Errorf("expected newest layer to be cached: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "README")); err != nil {
+		t.Errorf("expected unrelated file to be retained: %v", err)
+	}
+}
+
+func TestCacheCollector(t *testing.T) {
+	c, err := binding.NewBoundedCache(t.TempDir(), binding.BoundedCacheOptions{})
+	if err != nil {
+		t.Fatalf("unable to create cache: %v", err)
+	}
+	h := putLayer(t, c, 1024)
+	if _, err := c.Get(h); err != nil {
+		t.Fatalf("expected layer to be cached: %v", err)
+	}
+
+	expected := `
+# HELP conventions_image_cache_entries Number of image layers in the cache.
+# TYPE conventions_image_cache_entries gauge
+conventions_image_cache_entries 1
+# HELP conventions_image_cache_evictions_total Number of image layers evicted from the cache.
+# TYPE conventions_image_cache_evictions_total counter
+conventions_image_cache_evictions_total 0
+# HELP conventions_image_cache_hits_total Number of image layers served from the cache.
+# TYPE conventions_image_cache_hits_total counter
+conventions_image_cache_hits_total 1
+# HELP conventions_image_cache_misses_total Number of image layers not found in the cache.
+# TYPE conventions_image_cache_misses_total counter
+conventions_image_cache_misses_total 0
+`
+	collector := binding.NewCacheCollector(c)
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"conventions_image_cache_entries",
+		"conventions_image_cache_evictions_total",
+		"conventions_image_cache_hits_total",
+		"conventions_image_cache_misses_total",
+	); err != nil {
+		t.Errorf("unexpected metrics: %v", err)
+	}
+}
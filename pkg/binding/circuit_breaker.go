@@ -0,0 +1,187 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+)
+
+// CircuitBreakerOptions configures when the circuit for a convention opens and closes.
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failed requests that open the circuit.
+	// Zero disables the circuit breaker.
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before a trial request is allowed.
+	// Defaults to thirty seconds.
+	OpenDuration time.Duration
+}
+
+// CircuitOpenError is returned for requests to a convention whose circuit is open.
+type CircuitOpenError struct {
+	Convention string
+	// RetryTime is when the circuit allows a trial request.
+	RetryTime time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker for convention %s is open until %s", e.Convention, e.RetryTime.UTC().Format(time.RFC3339))
+}
+
+// CircuitBreakers tracks the circuit for each convention by name. Requests are fast-failed
+// while the circuit is open. Once the open duration elapses the circuit is half-open and a
+// single trial request is sent, closing the circuit when it succeeds or opening it again when
+// it fails. A trial without an outcome within the open duration is replaced by another.
+type CircuitBreakers struct {
+	opts CircuitBreakerOptions
+	now  func() time.Time
+
+	m        sync.Mutex
+	circuits map[string]*circuit
+	changes  chan string
+}
+
+type circuit struct {
+	state               conventionsv1alpha1.CircuitBreakerState
+	consecutiveFailures int32
+	lastTransitionTime  time.Time
+	retryTime           time.Time
+	message             string
+}
+
+// NewCircuitBreakers creates circuit breakers sharing the same options.
+func NewCircuitBreakers(opts CircuitBreakerOptions) *CircuitBreakers {
+	if opts.OpenDuration == 0 {
+		opts.OpenDuration = 30 * time.Second
+	}
+	return &CircuitBreakers{
+		opts:     opts,
+		now:      time.Now,
+		circuits: map[string]*circuit{},
+		changes:  make(chan string, 100),
+	}
+}
+
+// Changes receives the name of a convention each time its circuit changes state.
+func (b *CircuitBreakers) Changes() <-chan string {
+	return b.changes
+}
+
+// Allow returns a CircuitOpenError when a request to the convention must not be sent.
+func (b *CircuitBreakers) Allow(convention string) error {
+	if b == nil || b.opts.FailureThreshold == 0 {
+		return nil
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	c, ok := b.circuits[convention]
+	if !ok {
+		return nil
+	}
+	switch c.state {
+	case conventionsv1alpha1.OpenCircuitBreakerState:
+		if b.now().Before(c.retryTime) {
+			return &CircuitOpenError{Convention: convention, RetryTime: c.retryTime}
+		}
+		// this request is the trial, others fail fast until it completes
+		c.retryTime = b.now().Add(b.opts.OpenDuration)
+		b.transition(convention, c, conventionsv1alpha1.HalfOpenCircuitBreakerState)
+		return nil
+	case conventionsv1alpha1.HalfOpenCircuitBreakerState:
+		if b.now().Before(c.retryTime) {
+			return &CircuitOpenError{Convention: convention, RetryTime: c.retryTime}
+		}
+		// the outcome of the trial was never recorded, this request is the next trial
+		c.retryTime = b.now().Add(b.opts.OpenDuration)
+		return nil
+	}
+	return nil
+}
+
+// Record updates the circuit for the convention with the outcome of a request.
+func (b *CircuitBreakers) Record(convention string, err error) {
+	if b == nil || b.opts.FailureThreshold == 0 {
+		return
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	c, ok := b.circuits[convention]
+	if !ok {
+		if err == nil {
+			// closed circuits without failures are not tracked
+			return
+		}
+		c = &circuit{state: conventionsv1alpha1.ClosedCircuitBreakerState, lastTransitionTime: b.now()}
+		b.circuits[convention] = c
+	}
+	if err == nil {
+		c.consecutiveFailures = 0
+		c.message = ""
+		if c.state != conventionsv1alpha1.ClosedCircuitBreakerState {
+			b.transition(convention, c, conventionsv1alpha1.ClosedCircuitBreakerState)
+		}
+		return
+	}
+	c.consecutiveFailures++
+	c.message = err.Error()
+	if c.state == conventionsv1alpha1.HalfOpenCircuitBreakerState || int(c.consecutiveFailures) >= b.opts.FailureThreshold {
+		c.retryTime = b.now().Add(b.opts.OpenDuration)
+		b.transition(convention, c, conventionsv1alpha1.OpenCircuitBreakerState)
+	}
+}
+
+// Status returns the state of the circuit for the convention, nil when the circuit breaker is
+// disabled.
+func (b *CircuitBreakers) Status(convention string) *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus {
+	if b == nil || b.opts.FailureThreshold == 0 {
+		return nil
+	}
+	b.m.Lock()
+	defer b.m.Unlock()
+	c, ok := b.circuits[convention]
+	if !ok {
+		return &conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus{
+			State: conventionsv1alpha1.ClosedCircuitBreakerState,
+		}
+	}
+	status := &conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus{
+		State:               c.state,
+		ConsecutiveFailures: c.consecutiveFailures,
+		LastTransitionTime:  &metav1.Time{Time: c.lastTransitionTime},
+		Message:             c.message,
+	}
+	if c.state != conventionsv1alpha1.ClosedCircuitBreakerState {
+		status.RetryTime = &metav1.Time{Time: c.retryTime}
+	}
+	return status
+}
+
+// transition must be called while holding the lock.
+func (b *CircuitBreakers) transition(convention string, c *circuit, state conventionsv1alpha1.CircuitBreakerState) {
+	c.state = state
+	c.lastTransitionTime = b.now()
+	select {
+	case b.changes <- convention:
+	default:
+		// the receiver is behind, the status catches up on its next reconcile
+	}
+}
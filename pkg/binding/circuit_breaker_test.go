@@ -0,0 +1,133 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	b := binding.NewCircuitBreakers(binding.CircuitBreakerOptions{
+		FailureThreshold: 2,
+		OpenDuration:     50 * time.Millisecond,
+	})
+	failure := fmt.Errorf("connection refused")
+
+	expectState := func(expected conventionsv1alpha1.CircuitBreakerState) {
+		t.Helper()
+		if actual := b.Status("test").State; actual != expected {
+			t.Errorf("expected state %s, got %s", expected, actual)
+		}
+	}
+	expectAllowed := func(expected bool) {
+		t.Helper()
+		err := b.Allow("test")
+		var circuitErr *binding.CircuitOpenError
+		if expected == errors.As(err, &circuitErr) {
+			t.Errorf("expected allowed %v, got %v", expected, err)
+		}
+	}
+
+	expectState(conventionsv1alpha1.ClosedCircuitBreakerState)
+	b.Record("test", failure)
+	expectState(conventionsv1alpha1.ClosedCircuitBreakerState)
+	expectAllowed(true)
+
+	// trips after consecutive failures
+	b.Record("test", failure)
+	expectState(conventionsv1alpha1.OpenCircuitBreakerState)
+	if status := b.Status("test"); status.ConsecutiveFailures != 2 || status.Message != "connection refused" || status.RetryTime == nil {
+		t.Errorf("unexpected status %+v", status)
+	}
+	if name := <-b.Changes(); name != "test" {
+		t.Errorf("expected change for test, got %s", name)
+	}
+	expectAllowed(false)
+	// other conventions are not affected
+	if err := b.Allow("other"); err != nil {
+		t.Errorf("expected other convention to be allowed, got %v", err)
+	}
+
+	// a single trial request once the open duration elapses
+	time.Sleep(60 * time.Millisecond)
+	expectAllowed(true)
+	expectState(conventionsv1alpha1.HalfOpenCircuitBreakerState)
+	// requests waiting for the trial are retried after it completes, not immediately
+	var circuitErr *binding.CircuitOpenError
+	if err := b.Allow("test"); !errors.As(err, &circuitErr) || !circuitErr.RetryTime.After(time.Now()) {
+		t.Errorf("expected the half-open circuit to be retried in the future, got %v", err)
+	}
+
+	// a failed trial opens the circuit again
+	b.Record("test", failure)
+	expectState(conventionsv1alpha1.OpenCircuitBreakerState)
+	expectAllowed(false)
+
+	// a successful trial closes the circuit
+	time.Sleep(60 * time.Millisecond)
+	expectAllowed(true)
+	b.Record("test", nil)
+	expectState(conventionsv1alpha1.ClosedCircuitBreakerState)
+	if status := b.Status("test"); status.ConsecutiveFailures != 0 || status.RetryTime != nil {
+		t.Errorf("unexpected status %+v", status)
+	}
+	expectAllowed(true)
+}
+
+func TestCircuitBreakersLostTrial(t *testing.T) {
+	b := binding.NewCircuitBreakers(binding.CircuitBreakerOptions{
+		FailureThreshold: 1,
+		OpenDuration:     50 * time.Millisecond,
+	})
+	b.Record("test", fmt.Errorf("connection refused"))
+	time.Sleep(60 * time.Millisecond)
+	if err := b.Allow("test"); err != nil {
+		t.Fatalf("expected a trial request, got %v", err)
+	}
+
+	// the trial never records its outcome, another trial is allowed once the open duration elapses
+	if err := b.Allow("test"); err == nil {
+		t.Errorf("expected a single trial request")
+	}
+	time.Sleep(60 * time.Millisecond)
+	if err := b.Allow("test"); err != nil {
+		t.Errorf("expected another trial request, got %v", err)
+	}
+	if state := b.Status("test").State; state != conventionsv1alpha1.HalfOpenCircuitBreakerState {
+		t.Errorf("expected state %s, got %s", conventionsv1alpha1.HalfOpenCircuitBreakerState, state)
+	}
+}
+
+func TestCircuitBreakersDisabled(t *testing.T) {
+	for _, b := range []*binding.CircuitBreakers{nil, binding.NewCircuitBreakers(binding.CircuitBreakerOptions{})} {
+		for i := 0; i < 10; i++ {
+			b.Record("test", fmt.Errorf("connection refused"))
+		}
+		if err := b.Allow("test"); err != nil {
+			t.Errorf("expected disabled circuit breaker to allow requests, got %v", err)
+		}
+		if status := b.Status("test"); status != nil {
+			t.Errorf("expected no status for disabled circuit breaker, got %+v", status)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"os"
+
+	webhookutil "k8s.io/apiserver/pkg/util/webhook"
+	"k8s.io/client-go/rest"
+)
+
+// ClientCertificate is the certificate and key presented to convention servers that require
+// client authentication. The files are reloaded periodically, so a mounted Secret, like one
+// issued by cert-manager, may be rotated without restarting the controller.
+type ClientCertificate struct {
+	CertFile string
+	KeyFile  string
+}
+
+// WithClientCertificate wraps the resolver to present the client certificate to every
+// convention server.
+func WithClientCertificate(delegate webhookutil.AuthenticationInfoResolver, cert ClientCertificate) (webhookutil.AuthenticationInfoResolver, error) {
+	for _, file := range []string{cert.CertFile, cert.KeyFile} {
+		if _, err := os.Stat(file); err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+	}
+	return &clientCertificateResolver{delegate: delegate, cert: cert}, nil
+}
+
+type clientCertificateResolver struct {
+	delegate webhookutil.AuthenticationInfoResolver
+	cert     ClientCertificate
+}
+
+func (r *clientCertificateResolver) ClientConfigFor(hostPort string) (*rest.Config, error) {
+	config, err := r.delegate.ClientConfigFor(hostPort)
+	if err != nil {
+		return nil, err
+	}
+	return r.withClientCertificate(config), nil
+}
+
+func (r *clientCertificateResolver) ClientConfigForService(serviceName, serviceNamespace string, servicePort int) (*rest.Config, error) {
+	config, err := r.delegate.ClientConfigForService(serviceName, serviceNamespace, servicePort)
+	if err != nil {
+		return nil, err
+	}
+	return r.withClientCertificate(config), nil
+}
+
+func (r *clientCertificateResolver) withClientCertificate(config *rest.Config) *rest.Config {
+	config = rest.CopyConfig(config)
+	// client-go reloads certificates from files, but not from data
+	config.TLSClientConfig.CertFile = r.cert.CertFile
+	config.TLSClientConfig.KeyFile = r.cert.KeyFile
+	config.TLSClientConfig.CertData = nil
+	config.TLSClientConfig.KeyData = nil
+	return config
+}
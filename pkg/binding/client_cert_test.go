@@ -0,0 +1,97 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apiserver/pkg/admission/plugin/webhook/testcerts"
+	webhookutil "k8s.io/apiserver/pkg/util/webhook"
+
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+)
+
+func TestConventionClientCertificate(t *testing.T) {
+	serverCert, err := tls.X509KeyPair(testcerts.ServerCert, testcerts.ServerKey)
+	if err != nil {
+		t.Fatalf("unable to load server certificate: %v", err)
+	}
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM(testcerts.CACert)
+	testServer := httptest.NewUnstartedServer(http.HandlerFunc(webhookHandler))
+	testServer.TLS = &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+	serverURL, _ := url.Parse(testServer.URL)
+
+	dir := t.TempDir()
+	cert := binding.ClientCertificate{
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+	}
+	if _, err := binding.WithClientCertificate(nil, cert); err == nil {
+		t.Errorf("WithClientCertificate() expected error for missing files")
+	}
+	if err := os.WriteFile(cert.CertFile, testcerts.ClientCert, 0600); err != nil {
+		t.Fatalf("unable to write client certificate: %v", err)
+	}
+	if err := os.WriteFile(cert.KeyFile, testcerts.ClientKey, 0600); err != nil {
+		t.Fatalf("unable to write client key: %v", err)
+	}
+
+	convention := binding.Convention{
+		Name: "test-os",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Name:      "webhook-test",
+				Namespace: "default",
+			},
+			CABundle: testcerts.CACert,
+		},
+	}
+	authInfoResolver, _ := webhookutil.NewDefaultAuthenticationInfoResolver("")
+
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: authInfoResolver,
+		ServiceResolver:  NewServiceResolver(*serverURL),
+	}
+	if _, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc); err == nil {
+		t.Errorf("Apply() expected error without a client certificate")
+	}
+
+	wc.AuthInfoResolver, err = binding.WithClientCertificate(authInfoResolver, cert)
+	if err != nil {
+		t.Fatalf("WithClientCertificate() unexpected error: %v", err)
+	}
+	if _, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc); err != nil {
+		t.Errorf("Apply() unexpected error: %v", err)
+	}
+}
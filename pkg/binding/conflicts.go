@@ -0,0 +1,161 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Conflict is a field of the template written by a convention that an earlier convention wrote.
+type Conflict struct {
+	// Path of the field written by the convention.
+	Path string
+	// Convention that previously wrote the field, or a field containing it.
+	Convention string
+	// Exclusive is true when either convention declared the field as exclusive.
+	Exclusive bool
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("overwrote %s set by convention %s", c.Path, c.Convention)
+}
+
+// ConflictError is returned when a convention writes a field that another convention also
+// writes, and either convention declared the field as exclusive.
+type ConflictError struct {
+	Convention string
+	Conflicts  []Conflict
+}
+
+func (e *ConflictError) Error() string {
+	conflicts := make([]string, len(e.Conflicts))
+	for i, conflict := range e.Conflicts {
+		conflicts[i] = conflict.String()
+	}
+	return fmt.Sprintf("convention %s conflicts on exclusive fields: %s", e.Convention, strings.Join(conflicts, ", "))
+}
+
+// fieldWriters tracks the convention that last wrote each field of the template, similar to the
+// managedFields of server-side apply.
+type fieldWriters struct {
+	// writers of each field path
+	writers map[string]string
+	// exclusive field patterns of each convention that wrote to the template
+	exclusive map[string][]string
+}
+
+func newFieldWriters() *fieldWriters {
+	return &fieldWriters{
+		writers:   map[string]string{},
+		exclusive: map[string][]string{},
+	}
+}
+
+// write records the convention as the writer of the fields, returning a conflict for each field
+// that overlaps a field last written by another convention.
+func (w *fieldWriters) write(convention Convention, fields []string) []Conflict {
+	w.exclusive[convention.Name] = convention.ExclusiveFields
+
+	var conflicts []Conflict
+	for _, field := range fields {
+		writers := map[string]bool{}
+		for previous, writer := range w.writers {
+			if !overlappingFields(field, previous) {
+				continue
+			}
+			delete(w.writers, previous)
+			if writer != convention.Name {
+				writers[writer] = true
+			}
+		}
+		for _, writer := range slices.Sorted(maps.Keys(writers)) {
+			conflicts = append(conflicts, Conflict{
+				Path:       field,
+				Convention: writer,
+				Exclusive:  matchesAnyField(convention.ExclusiveFields, field) || matchesAnyField(w.exclusive[writer], field),
+			})
+		}
+		w.writers[field] = convention.Name
+	}
+	return conflicts
+}
+
+// exclusiveConflicts returns the conflicts on fields declared as exclusive.
+func exclusiveConflicts(conflicts []Conflict) []Conflict {
+	var exclusive []Conflict
+	for _, conflict := range conflicts {
+		if conflict.Exclusive {
+			exclusive = append(exclusive, conflict)
+		}
+	}
+	return exclusive
+}
+
+// overlappingFields returns true when the fields are the same, or one contains the other.
+func overlappingFields(a, b string) bool {
+	return a == "." || b == "." || containsField(a, b) || containsField(b, a)
+}
+
+// containsField returns true when the field is, or is within, the parent field.
+func containsField(parent, field string) bool {
+	return field == parent || strings.HasPrefix(field, parent+".") || strings.HasPrefix(field, parent+"[")
+}
+
+// matchesAnyField returns true when the field overlaps a field matched by any of the patterns.
+// Patterns are matched per path.Match with brackets matched literally, so `*` matches any
+// container in `.spec.containers[*].livenessProbe`.
+func matchesAnyField(patterns []string, field string) bool {
+	for _, pattern := range patterns {
+		for _, prefix := range fieldPrefixes(field) {
+			// the field is within the matched field
+			if matched, _ := path.Match(fieldPattern(pattern), prefix); matched {
+				return true
+			}
+		}
+		for _, prefix := range fieldPrefixes(pattern) {
+			// the field contains the matched field
+			if matched, _ := path.Match(fieldPattern(prefix), field); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var fieldPatternEscaper = strings.NewReplacer("[", `\[`, "]", `\]`)
+
+// fieldPattern escapes the brackets in the pattern, which index named list items rather than
+// define character classes.
+func fieldPattern(pattern string) string {
+	return fieldPatternEscaper.Replace(pattern)
+}
+
+// fieldPrefixes returns the field and each field containing it, like `.spec` and
+// `.spec.containers` for `.spec.containers[workload]`.
+func fieldPrefixes(field string) []string {
+	var prefixes []string
+	for i := 1; i < len(field); i++ {
+		if field[i] == '.' || field[i] == '[' {
+			prefixes = append(prefixes, field[:i])
+		}
+	}
+	return append(prefixes, field)
+}
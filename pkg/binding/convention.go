@@ -18,11 +18,22 @@ package binding
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apiserver/pkg/util/webhook"
 	webhookutil "k8s.io/apiserver/pkg/util/webhook"
+	"k8s.io/client-go/rest"
 
 	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
 	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
@@ -34,9 +45,39 @@ type Convention struct {
 	Selectors      []metav1.LabelSelector
 	Priority       conventionsv1alpha1.PriorityLevel
 	ClientConfig   admissionregistrationv1.WebhookClientConfig
+	BOMs           *conventionsv1alpha1.ClusterPodConventionWebhookBOMs
+	Mutations      *conventionsv1alpha1.ClusterPodConventionMutations
+	// ServiceAccountToken is sent as a bearer token with each request when set
+	ServiceAccountToken *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken
+	// Params are sent to the convention with each request
+	Params map[string]string
+	// Resources are sent to the convention with each request
+	Resources []unstructured.Unstructured
+	// Validating conventions allow or deny the template after the mutating conventions are
+	// applied, rather than changing it
+	Validating bool
+	// Order sorts conventions within the same priority
+	Order int32
+	// RunAfter and RunBefore are the names of conventions this convention is applied after or
+	// before, when they are applied to the same workload
+	RunAfter  []string
+	RunBefore []string
+	// ExclusiveFields are patterns for the fields of the template only this convention may write
+	ExclusiveFields []string
+	// Mandatory conventions are applied regardless of the conventions the PodIntent includes or
+	// excludes
+	Mandatory bool
+	// ExcludedConventions are the ids of the conventions within this convention the PodIntent
+	// excludes, they are sent to the convention with each request
+	ExcludedConventions []string
 }
 
-func (o *Convention) Apply(ctx context.Context, conventionRequest *webhookv1alpha1.PodConventionContext, wc WebhookConfig) (*webhookv1alpha1.PodConventionContext, error) {
+func (o *Convention) Apply(ctx context.Context, conventionRequest *webhookv1alpha1.PodConventionContext, wc WebhookConfig) (_ *webhookv1alpha1.PodConventionContext, err error) {
+	ctx, span := tracer.Start(ctx, "Convention.Apply", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("convention", o.Name),
+	))
+	defer func() { endSpan(span, err) }()
+
 	cc := o.WebhookClientConfig()
 	cm, err := NewClientManager(wc, webhookv1alpha1.GroupVersion, webhookv1alpha1.AddToScheme)
 	if err != nil {
@@ -48,17 +89,162 @@ func (o *Convention) Apply(ctx context.Context, conventionRequest *webhookv1alph
 	}
 
 	r := webClient.Post().Body(conventionRequest)
+	for key, values := range traceHeaders(ctx) {
+		r.SetHeader(key, values...)
+	}
+	// fast-fail before requesting a token, an open circuit must not reach the API server either
+	if err := wc.CircuitBreakers.Allow(o.Name); err != nil {
+		return nil, err
+	}
+	if o.ServiceAccountToken != nil {
+		expirationSeconds := int64(3600)
+		if o.ServiceAccountToken.ExpirationSeconds != nil {
+			expirationSeconds = *o.ServiceAccountToken.ExpirationSeconds
+		}
+		token, err := wc.ServiceAccountTokens.Token(ctx, o.ServiceAccountToken.Audience, expirationSeconds)
+		if err != nil {
+			// a trial request must report its outcome, otherwise the circuit stays half-open
+			wc.CircuitBreakers.Record(o.Name, err)
+			return nil, err
+		}
+		r.SetHeader("Authorization", "Bearer "+token)
+	}
 	enrichedIntent := &webhookv1alpha1.PodConventionContext{}
+	start := time.Now()
 	res := r.Do(ctx)
-	if res.Error() != nil {
-		return nil, res.Error()
+	observeWebhookRequest(o.Name, start, res.Error())
+	if err := res.Error(); err != nil {
+		classifiedErr := conventionErrorFrom(res)
+		if classifiedErr == nil {
+			classifiedErr = classifyWebhookError(err)
+		}
+		if ClassOf(classifiedErr) == ConventionRejectedClass {
+			// the convention is healthy, it refused the workload
+			wc.CircuitBreakers.Record(o.Name, nil)
+		} else {
+			wc.CircuitBreakers.Record(o.Name, err)
+		}
+		return nil, classifiedErr
 	}
+	wc.CircuitBreakers.Record(o.Name, nil)
 	if err := res.Into(enrichedIntent); err != nil {
-		return nil, err
+		return nil, &ClassifiedError{Class: WebhookInvalidResponseClass, Err: err}
+	}
+	if enrichedIntent.Status.PatchType == nil && len(enrichedIntent.Status.Patch) != 0 {
+		// applying the template instead would silently drop the convention's changes
+		return nil, &ClassifiedError{Class: WebhookInvalidResponseClass, Err: fmt.Errorf("response has a patch without a patch type")}
+	}
+	if enrichedIntent.Status.PatchType != nil {
+		template, err := applyPatch(&conventionRequest.Spec.Template, enrichedIntent.Status)
+		if err != nil {
+			return nil, &ClassifiedError{Class: WebhookInvalidResponseClass, Err: err}
+		}
+		enrichedIntent.Status.Template = *template
 	}
 	return enrichedIntent, nil
 }
 
+// conventionErrorFrom returns the structured error in a failed response, if any.
+func conventionErrorFrom(res rest.Result) error {
+	body, _ := res.Raw()
+	response := &webhookv1alpha1.PodConventionContext{}
+	if err := json.Unmarshal(body, response); err != nil || response.Status.Error == nil {
+		return nil
+	}
+	class := ConventionRejectedClass
+	// a panic is a bug in the convention server rather than a refusal of the workload, it backs
+	// off and trips the circuit breaker like other server errors
+	if response.Status.Error.Retryable || response.Status.Error.Reason == webhookv1alpha1.ConventionPanicReason {
+		class = WebhookServerErrorClass
+	}
+	return &ClassifiedError{Class: class, Err: &ConventionError{Response: *response.Status.Error}}
+}
+
+// applyPatch applies the patch returned by a convention to the template sent in the request.
+func applyPatch(template *corev1.PodTemplateSpec, status webhookv1alpha1.PodConventionContextStatus) (*corev1.PodTemplateSpec, error) {
+	if *status.PatchType != webhookv1alpha1.JSONPatchType {
+		return nil, fmt.Errorf("unsupported patch type %q", *status.PatchType)
+	}
+	patch, err := jsonpatch.DecodePatch(status.Patch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %w", err)
+	}
+	original, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := patch.Apply(original)
+	if err != nil {
+		return nil, fmt.Errorf("unable to apply JSON patch: %w", err)
+	}
+	result := &corev1.PodTemplateSpec{}
+	if err := json.Unmarshal(patched, result); err != nil {
+		return nil, fmt.Errorf("JSON patch produced an invalid template: %w", err)
+	}
+	return result, nil
+}
+
+// FilterImageConfig returns a copy of the image configs containing only the BOMs accepted by
+// the convention.
+func (o *Convention) FilterImageConfig(imageConfigs []webhookv1alpha1.ImageConfig) []webhookv1alpha1.ImageConfig {
+	if o.BOMs == nil || imageConfigs == nil {
+		return imageConfigs
+	}
+	filtered := make([]webhookv1alpha1.ImageConfig, len(imageConfigs))
+	for i := range imageConfigs {
+		filtered[i] = imageConfigs[i]
+		filtered[i].BOMs = nil
+		if o.BOMs.None {
+			continue
+		}
+		for _, bom := range imageConfigs[i].BOMs {
+			if o.acceptsBOM(bom.Name) {
+				filtered[i].BOMs = append(filtered[i].BOMs, bom)
+			}
+		}
+	}
+	return filtered
+}
+
+func (o *Convention) acceptsBOM(name string) bool {
+	if len(o.BOMs.Formats) != 0 {
+		format := BOMFormat(name)
+		found := false
+		for _, f := range o.BOMs.Formats {
+			if f == format {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(o.BOMs.Names) != 0 {
+		for _, pattern := range o.BOMs.Names {
+			if matched, _ := path.Match(pattern, name); matched {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// BOMFormat detects the format of a BOM from its name, following the buildpacks SBOM file naming
+// conventions. An empty format is returned for unrecognized names.
+func BOMFormat(name string) conventionsv1alpha1.BOMFormat {
+	switch {
+	case strings.HasSuffix(name, ".cdx.json"):
+		return conventionsv1alpha1.CycloneDXBOMFormat
+	case strings.HasSuffix(name, ".spdx.json"):
+		return conventionsv1alpha1.SPDXBOMFormat
+	case strings.HasSuffix(name, ".syft.json"):
+		return conventionsv1alpha1.SyftBOMFormat
+	}
+	return ""
+}
+
 func (o *Convention) WebhookClientConfig() webhook.ClientConfig {
 	cc := webhook.ClientConfig{
 		Name:     o.Name,
@@ -20,14 +20,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,6 +40,7 @@ import (
 	webhooktesting "k8s.io/apiserver/pkg/admission/plugin/webhook/testing"
 	"k8s.io/apiserver/pkg/util/webhook"
 
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
 	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
 	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
 )
@@ -53,6 +59,8 @@ var (
 func strPtr(s string) *string { return &s }
 
 func intPtr(s int32) *int32 { return &s }
+
+func jsonPatchTypePtr(p webhookv1alpha1.PatchType) *webhookv1alpha1.PatchType { return &p }
 func TestNewWebhookClientConfig(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -192,6 +200,74 @@ func TestConvention(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		name: "convention with json patch response",
+		convention: binding.Convention{
+			Name: "test-os",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				URL: strPtr(fmt.Sprintf("%s/%s", serverURL, "jsonpatch")),
+			},
+		},
+		conventionContext: webhookv1alpha1.PodConventionContextSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "base", Image: "ubuntu",
+					}},
+				},
+			},
+		},
+		expects: webhookv1alpha1.PodConventionContextStatus{
+			AppliedConventions: []string{"patch/label"},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"patched": "true"},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "base", Image: "ubuntu",
+					}},
+				},
+			},
+			Patch:     []byte(`[{"op":"add","path":"/metadata/labels","value":{"patched":"true"}}]`),
+			PatchType: jsonPatchTypePtr(webhookv1alpha1.JSONPatchType),
+		},
+	}, {
+		name: "convention server with patch that does not apply",
+		convention: binding.Convention{
+			Name: "test-os",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				URL: strPtr(fmt.Sprintf("%s/%s", serverURL, "badpatch")),
+			},
+		},
+		conventionContext: webhookv1alpha1.PodConventionContextSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "base", Image: "ubuntu",
+					}},
+				},
+			},
+		},
+		expectsErr: true,
+	}, {
+		name: "convention server with unknown patch type",
+		convention: binding.Convention{
+			Name: "test-os",
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				URL: strPtr(fmt.Sprintf("%s/%s", serverURL, "unknownpatchtype")),
+			},
+		},
+		conventionContext: webhookv1alpha1.PodConventionContextSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "base", Image: "ubuntu",
+					}},
+				},
+			},
+		},
+		expectsErr: true,
 	}, {
 		name: "convention server with wrong content type",
 		convention: binding.Convention{
@@ -316,6 +392,215 @@ func TestConvention(t *testing.T) {
 
 }
 
+func TestConventionTraceContext(t *testing.T) {
+	testServer := NewTestServer(t)
+	testServer.Start()
+	defer testServer.Close()
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "test")
+	defer span.End()
+
+	convention := binding.Convention{
+		Name: "test-os",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			URL: strPtr(fmt.Sprintf("%s/%s", testServer.URL, "traceparent")),
+		},
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+	}
+	actual, err := convention.Apply(ctx, &webhookv1alpha1.PodConventionContext{}, wc)
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	sc := span.SpanContext()
+	expected := []string{fmt.Sprintf("00-%s-%s-%s", sc.TraceID(), sc.SpanID(), sc.TraceFlags())}
+	if diff := cmp.Diff(expected, actual.Status.AppliedConventions); diff != "" {
+		t.Errorf("Apply() traceparent (-expected, +actual) = %v", diff)
+	}
+}
+
+func TestConventionCircuitBreaker(t *testing.T) {
+	testServer := NewTestServer(t)
+	testServer.Start()
+	defer testServer.Close()
+
+	convention := binding.Convention{
+		Name: "test-os",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			URL: strPtr(fmt.Sprintf("%s/%s", testServer.URL, "wrongstatuscode")),
+		},
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		CircuitBreakers:  binding.NewCircuitBreakers(binding.CircuitBreakerOptions{FailureThreshold: 1, OpenDuration: time.Hour}),
+	}
+	var circuitErr *binding.CircuitOpenError
+	if _, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc); err == nil || errors.As(err, &circuitErr) {
+		t.Errorf("Apply() expected the request to fail, got %v", err)
+	}
+	if _, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc); !errors.As(err, &circuitErr) {
+		t.Errorf("Apply() expected the circuit to be open, got %v", err)
+	}
+}
+
+func TestConventionErrorClass(t *testing.T) {
+	testServer := NewTestServer(t)
+	testServer.Start()
+	defer testServer.Close()
+	closedServer := NewTestServer(t)
+	closedServer.Start()
+	closedServer.Close()
+
+	tests := []struct {
+		name          string
+		url           string
+		expects       binding.ErrorClass
+		expectsErrMsg string
+	}{{
+		name:    "server error",
+		url:     fmt.Sprintf("%s/%s", testServer.URL, "wrongstatuscode"),
+		expects: binding.WebhookServerErrorClass,
+	}, {
+		name:    "rejected request",
+		url:     fmt.Sprintf("%s/%s", testServer.URL, "badrequest"),
+		expects: binding.WebhookInvalidResponseClass,
+	}, {
+		name:    "invalid response",
+		url:     fmt.Sprintf("%s/%s", testServer.URL, "wrongobj"),
+		expects: binding.WebhookInvalidResponseClass,
+	}, {
+		name:    "invalid patch",
+		url:     fmt.Sprintf("%s/%s", testServer.URL, "badpatch"),
+		expects: binding.WebhookInvalidResponseClass,
+	}, {
+		name:          "patch without patch type",
+		url:           fmt.Sprintf("%s/%s", testServer.URL, "missingpatchtype"),
+		expects:       binding.WebhookInvalidResponseClass,
+		expectsErrMsg: "response has a patch without a patch type",
+	}, {
+		name:    "unreachable server",
+		url:     closedServer.URL,
+		expects: binding.TransientErrorClass,
+	}, {
+		name:          "retryable convention error",
+		url:           fmt.Sprintf("%s/%s", testServer.URL, "retryableerror"),
+		expects:       binding.WebhookServerErrorClass,
+		expectsErrMsg: "DatabaseUnavailable: unable to look up the workload",
+	}, {
+		name:          "panicking convention",
+		url:           fmt.Sprintf("%s/%s", testServer.URL, "panic"),
+		expects:       binding.WebhookServerErrorClass,
+		expectsErrMsg: "ConventionPanic: convention panicked: assignment to entry in nil map",
+	}, {
+		name:          "rejected by convention",
+		url:           fmt.Sprintf("%s/%s", testServer.URL, "rejected"),
+		expects:       binding.ConventionRejectedClass,
+		expectsErrMsg: "UnsupportedImage: images must be signed (field spec.containers[0].image)",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			convention := binding.Convention{
+				Name: "test-os",
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					URL: strPtr(test.url),
+				},
+			}
+			wc := binding.WebhookConfig{
+				AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+			}
+			_, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc)
+			if err == nil {
+				t.Fatalf("Apply() expected error")
+			}
+			if class := binding.ClassOf(err); class != test.expects {
+				t.Errorf("Apply() expected error class %q, got %q: %v", test.expects, class, err)
+			}
+			if test.expectsErrMsg != "" && err.Error() != test.expectsErrMsg {
+				t.Errorf("Apply() expected error message %q, got %q", test.expectsErrMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestConventionFilterImageConfig(t *testing.T) {
+	imageConfigs := []webhookv1alpha1.ImageConfig{{
+		Image: "example.com/app@sha256:abc",
+		BOMs: []webhookv1alpha1.BOM{
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.cdx.json"},
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.spdx.json"},
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.syft.json"},
+			{Name: "cnb-app:/layers/sbom/launch/other/sbom.cdx.json"},
+		},
+	}}
+	tests := []struct {
+		name    string
+		boms    *conventionsv1alpha1.ClusterPodConventionWebhookBOMs
+		expects []webhookv1alpha1.BOM
+	}{{
+		name:    "all boms",
+		expects: imageConfigs[0].BOMs,
+	}, {
+		name: "no boms",
+		boms: &conventionsv1alpha1.ClusterPodConventionWebhookBOMs{
+			None: true,
+		},
+	}, {
+		name: "by format",
+		boms: &conventionsv1alpha1.ClusterPodConventionWebhookBOMs{
+			Formats: []conventionsv1alpha1.BOMFormat{conventionsv1alpha1.CycloneDXBOMFormat, conventionsv1alpha1.SPDXBOMFormat},
+		},
+		expects: []webhookv1alpha1.BOM{
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.cdx.json"},
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.spdx.json"},
+			{Name: "cnb-app:/layers/sbom/launch/other/sbom.cdx.json"},
+		},
+	}, {
+		name: "by name",
+		boms: &conventionsv1alpha1.ClusterPodConventionWebhookBOMs{
+			Names: []string{"cnb-app:/layers/sbom/launch/buildpack/*"},
+		},
+		expects: []webhookv1alpha1.BOM{
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.cdx.json"},
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.spdx.json"},
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.syft.json"},
+		},
+	}, {
+		name: "by name across buildpacks",
+		boms: &conventionsv1alpha1.ClusterPodConventionWebhookBOMs{
+			Names: []string{"cnb-app:/layers/sbom/launch/*/sbom.cdx.json"},
+		},
+		expects: []webhookv1alpha1.BOM{
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.cdx.json"},
+			{Name: "cnb-app:/layers/sbom/launch/other/sbom.cdx.json"},
+		},
+	}, {
+		name: "by name and format",
+		boms: &conventionsv1alpha1.ClusterPodConventionWebhookBOMs{
+			Names:   []string{"cnb-app:/layers/sbom/launch/buildpack/*"},
+			Formats: []conventionsv1alpha1.BOMFormat{conventionsv1alpha1.CycloneDXBOMFormat},
+		},
+		expects: []webhookv1alpha1.BOM{
+			{Name: "cnb-app:/layers/sbom/launch/buildpack/sbom.cdx.json"},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			convention := binding.Convention{Name: "test", BOMs: test.boms}
+			actual := convention.FilterImageConfig(imageConfigs)
+			if diff := cmp.Diff(test.expects, actual[0].BOMs); diff != "" {
+				t.Errorf("FilterImageConfig() (-expected, +actual) = %v", diff)
+			}
+			if len(imageConfigs[0].BOMs) != 4 {
+				t.Errorf("FilterImageConfig() must not mutate the input")
+			}
+		})
+	}
+}
+
 type serviceResolver struct {
 	base url.URL
 }
@@ -357,6 +642,38 @@ func webhookHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	switch r.URL.Path {
+	case "/jsonpatch":
+		w.Header().Set("Content-Type", "application/json")
+		patchType := webhookv1alpha1.JSONPatchType
+		validResponse.Status.Template = corev1.PodTemplateSpec{}
+		validResponse.Status.Patch = []byte(`[{"op":"add","path":"/metadata/labels","value":{"patched":"true"}}]`)
+		validResponse.Status.PatchType = &patchType
+		validResponse.Status.AppliedConventions = []string{"patch/label"}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/badpatch":
+		w.Header().Set("Content-Type", "application/json")
+		patchType := webhookv1alpha1.JSONPatchType
+		validResponse.Status.Patch = []byte(`[{"op":"replace","path":"/spec/doesnotexist","value":"nope"}]`)
+		validResponse.Status.PatchType = &patchType
+		json.NewEncoder(w).Encode(validResponse)
+	case "/unknownpatchtype":
+		w.Header().Set("Content-Type", "application/json")
+		patchType := webhookv1alpha1.PatchType("MergePatch")
+		validResponse.Status.Patch = []byte(`{}`)
+		validResponse.Status.PatchType = &patchType
+		json.NewEncoder(w).Encode(validResponse)
+	case "/missingpatchtype":
+		w.Header().Set("Content-Type", "application/json")
+		validResponse.Status.Patch = []byte(`[{"op":"add","path":"/metadata/labels","value":{"patched":"true"}}]`)
+		json.NewEncoder(w).Encode(validResponse)
+	case "/authorization":
+		w.Header().Set("Content-Type", "application/json")
+		validResponse.Status.AppliedConventions = []string{r.Header.Get("Authorization")}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/traceparent":
+		w.Header().Set("Content-Type", "application/json")
+		validResponse.Status.AppliedConventions = []string{r.Header.Get("traceparent")}
+		json.NewEncoder(w).Encode(validResponse)
 	case "/wrongcontenttype":
 		w.Header().Set("Content-Type", "application/unrecognized")
 	case "/wrongobj":
@@ -365,6 +682,30 @@ func webhookHandler(w http.ResponseWriter, r *http.Request) {
 	case "/wrongstatuscode":
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusBadGateway)
+	case "/badrequest":
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+	case "/retryableerror":
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		validResponse.Status = webhookv1alpha1.PodConventionContextStatus{
+			Error: &webhookv1alpha1.ConventionError{Reason: "DatabaseUnavailable", Message: "unable to look up the workload", Retryable: true},
+		}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/panic":
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		validResponse.Status = webhookv1alpha1.PodConventionContextStatus{
+			Error: &webhookv1alpha1.ConventionError{Reason: webhookv1alpha1.ConventionPanicReason, Message: "convention panicked: assignment to entry in nil map"},
+		}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/rejected":
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		validResponse.Status = webhookv1alpha1.PodConventionContextStatus{
+			Error: &webhookv1alpha1.ConventionError{Reason: "UnsupportedImage", Message: "images must be signed", Field: "spec.containers[0].image"},
+		}
+		json.NewEncoder(w).Encode(validResponse)
 	case "/readyprobe":
 		w.Header().Set("Content-Type", "application/json")
 		validResponse.Status.Template.Spec.Containers = append(validResponse.Status.Template.Spec.Containers, corev1.Container{
@@ -19,7 +19,8 @@ package binding
 import (
 	"context"
 	"fmt"
-	"sort"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -28,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
 	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
@@ -35,19 +37,29 @@ import (
 
 type Conventions []Convention
 
-func (c *Conventions) FilterAndSort(collectedLabels map[string]labels.Set) (Conventions, error) {
-	filteredConventions, err := c.Filter(collectedLabels)
+func (c *Conventions) FilterAndSort(collectedLabels map[string]labels.Set, selection *conventionsv1alpha1.PodIntentConventions) (Conventions, error) {
+	filteredConventions, err := c.Filter(collectedLabels, selection)
 	if err != nil {
 		return nil, err
 	}
-	return filteredConventions.Sort(), nil
+	return filteredConventions.Sort()
 }
 
-func (c *Conventions) Filter(collectedLabels map[string]labels.Set) (Conventions, error) {
+// Filter returns the conventions whose selectors match the labels, and that the PodIntent's
+// selection of conventions includes. Mandatory conventions are returned when their selectors
+// match regardless of the selection. The conventions within each convention the selection
+// excludes are set as the convention's ExcludedConventions.
+func (c *Conventions) Filter(collectedLabels map[string]labels.Set, selection *conventionsv1alpha1.PodIntentConventions) (Conventions, error) {
 	originalOrder := *c
 
 	var filteredSources Conventions
 	for _, source := range originalOrder {
+		if !source.Mandatory {
+			if !selection.Includes(source.Name) {
+				continue
+			}
+			source.ExcludedConventions = selection.ExcludedWithin(source.Name)
+		}
 		selectors := source.Selectors
 		if len(selectors) == 0 {
 			selectors = []metav1.LabelSelector{
@@ -71,58 +83,226 @@ func (c *Conventions) Filter(collectedLabels map[string]labels.Set) (Conventions
 	return filteredSources, nil
 }
 
-func (c *Conventions) Sort() Conventions {
-	originalConventions := *c
-	sort.Slice(originalConventions, func(i, j int) bool {
-		a := originalConventions[i]
-		b := originalConventions[j]
-		if a.Priority == b.Priority {
-			return a.Name < b.Name
-		}
-		return a.Priority == conventionsv1alpha1.EarlyPriority || b.Priority == conventionsv1alpha1.LatePriority
-	})
-	return originalConventions
+// Sort orders the conventions so each convention is applied after the conventions it runs after
+// and before the conventions it runs before. References to conventions that are not being applied
+// are ignored. Otherwise conventions are applied by priority, then order, then name. An error is
+// returned when the references form a cycle.
+func (c *Conventions) Sort() (Conventions, error) {
+	conventions := *c
+
+	byName := map[string][]int{}
+	for i, convention := range conventions {
+		byName[convention.Name] = append(byName[convention.Name], i)
+	}
+	// next holds the indexes of the conventions that must be applied after each convention
+	next := make([]sets.Set[int], len(conventions))
+	for i := range next {
+		next[i] = sets.New[int]()
+	}
+	for i, convention := range conventions {
+		for _, name := range convention.RunAfter {
+			for _, j := range byName[name] {
+				next[j].Insert(i)
+			}
+		}
+		for _, name := range convention.RunBefore {
+			next[i].Insert(byName[name]...)
+		}
+	}
+	blockers := make([]int, len(conventions))
+	for i := range next {
+		next[i].Delete(i)
+		for j := range next[i] {
+			blockers[j]++
+		}
+	}
+
+	var ready []int
+	for i := range conventions {
+		if blockers[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+	var sorted Conventions
+	for len(ready) != 0 {
+		slices.SortFunc(ready, func(i, j int) int {
+			if c := compareConventions(conventions[i], conventions[j]); c != 0 {
+				return c
+			}
+			return i - j
+		})
+		i := ready[0]
+		ready = ready[1:]
+		sorted = append(sorted, conventions[i])
+		for j := range next[i] {
+			if blockers[j]--; blockers[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+	}
+
+	if len(sorted) != len(conventions) {
+		var unordered []string
+		for i, convention := range conventions {
+			if blockers[i] != 0 {
+				unordered = append(unordered, convention.Name)
+			}
+		}
+		slices.Sort(unordered)
+		return nil, fmt.Errorf("unable to order ClusterPodConventions %s, runAfter and runBefore references form a cycle", strings.Join(slices.Compact(unordered), ", "))
+	}
+	return sorted, nil
+}
+
+// priorityRank orders priorities, unknown priorities are treated as Normal.
+func priorityRank(priority conventionsv1alpha1.PriorityLevel) int {
+	switch priority {
+	case conventionsv1alpha1.EarlyPriority:
+		return 0
+	case conventionsv1alpha1.LatePriority:
+		return 2
+	default:
+		return 1
+	}
+}
+
+func compareConventions(a, b Convention) int {
+	switch {
+	case priorityRank(a.Priority) != priorityRank(b.Priority):
+		return priorityRank(a.Priority) - priorityRank(b.Priority)
+	case a.Order < b.Order:
+		return -1
+	case a.Order > b.Order:
+		return 1
+	default:
+		return strings.Compare(a.Name, b.Name)
+	}
+}
+
+// Mutating returns the conventions that apply changes to the template, in order.
+func (c Conventions) Mutating() Conventions {
+	var mutating Conventions
+	for _, convention := range c {
+		if !convention.Validating {
+			mutating = append(mutating, convention)
+		}
+	}
+	return mutating
+}
+
+// Validating returns the conventions that allow or deny the template, in order.
+func (c Conventions) Validating() Conventions {
+	var validating Conventions
+	for _, convention := range c {
+		if convention.Validating {
+			validating = append(validating, convention)
+		}
+	}
+	return validating
+}
+
+// ConventionResult is the outcome of applying a single convention.
+type ConventionResult struct {
+	Name string
+	// StrippedPaths are the changes made by the convention outside of its allowed mutation
+	// scopes that were discarded.
+	StrippedPaths []string
+	// Warnings reported by the convention.
+	Warnings []string
+	// Denials are the reasons a validating convention denied the template.
+	Denials []webhookv1alpha1.ValidationReason
+	// Conflicts are the fields written by the convention that earlier conventions wrote.
+	Conflicts []Conflict
+	// Changes are the fields of the template changed by the convention.
+	Changes []FieldChange
+}
+
+// conventionContextMetadata describes the PodIntent to the convention. The name identifies both
+// the PodIntent and the convention.
+func conventionContextMetadata(parent *conventionsv1alpha1.PodIntent, convention Convention) metav1.ObjectMeta {
+	annotations := maps.Clone(parent.Annotations)
+	// the PodIntent as last applied by kubectl, including the template, is not useful to conventions
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	return metav1.ObjectMeta{
+		Name:            fmt.Sprintf("%s-%s", parent.GetName(), convention.Name),
+		Namespace:       parent.Namespace,
+		Labels:          maps.Clone(parent.Labels),
+		Annotations:     annotations,
+		OwnerReferences: slices.Clone(parent.OwnerReferences),
+	}
 }
 
+// Apply calls each convention in order, passing the template returned by the previous
+// convention to the next. A result is returned for each convention applied. The convention that
+// last wrote each field is tracked, a convention that writes a field an earlier convention wrote
+// is reported as a conflict, failing when either convention declared the field as exclusive.
 func (c *Conventions) Apply(ctx context.Context,
 	parent *conventionsv1alpha1.PodIntent,
 	wc WebhookConfig,
 	rc RegistryConfig,
-) (*corev1.PodTemplateSpec, error) {
+) (*corev1.PodTemplateSpec, []ConventionResult, error) {
 	log := logr.FromContextOrDiscard(ctx)
 	if parent == nil {
-		return nil, fmt.Errorf("PodIntent value cannot be nil")
+		return nil, nil, fmt.Errorf("PodIntent value cannot be nil")
 	}
+	results := []ConventionResult{}
 	workload := parent.Spec.Template.AsPodTemplateSpec()
 	appliedConventions := []string{}
 	if str := workload.Annotations[conventionsv1alpha1.AppliedConventionsAnnotationKey]; str != "" {
 		appliedConventions = strings.Split(str, "\n")
 	}
+	writers := newFieldWriters()
 	for _, convention := range *c {
 		// fetch metadata for workload
 		imageConfigList, err := rc.ResolveImageMetadata(ctx, workload)
 		if err != nil {
 			log.Error(err, "fetching metadata for Images failed")
-			return nil, fmt.Errorf("failed to fetch metadata for Images: %v", err)
+			return nil, results, fmt.Errorf("failed to fetch metadata for Images: %w", err)
 		}
 		conventionRequestObj := &webhookv1alpha1.PodConventionContext{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: fmt.Sprintf("%s-%s", parent.GetName(), convention.Name),
-			},
+			ObjectMeta: conventionContextMetadata(parent, convention),
 			Spec: webhookv1alpha1.PodConventionContextSpec{
-				ImageConfig: imageConfigList,
-				Template:    *workload,
+				ImageConfig:         convention.FilterImageConfig(imageConfigList),
+				Template:            *workload,
+				Params:              convention.Params,
+				Resources:           convention.Resources,
+				ExcludedConventions: convention.ExcludedConventions,
 			},
 		}
 		conventionResp, err := convention.Apply(ctx, conventionRequestObj, wc)
 		if err != nil {
 			log.Error(err, "failed to apply convention", "Convention", convention)
-			return nil, fmt.Errorf("failed to apply convention with name %s: %s", convention.Name, err.Error())
+			return nil, results, fmt.Errorf("failed to apply convention with name %s: %w", convention.Name, err)
+		}
+		enforced, strippedPaths, err := convention.EnforceMutations(workload, &conventionResp.Status.Template)
+		if err != nil {
+			log.Error(err, "convention made changes that are not allowed", "convention", convention.Name)
+			return nil, results, err
+		}
+		if len(strippedPaths) != 0 {
+			log.Info("stripped changes that are not allowed", "convention", convention.Name, "paths", strippedPaths)
 		}
-		workloadDiff := cmp.Diff(workload, conventionResp.Status.Template, cmpopts.EquateEmpty())
+		changes, err := writtenFields(workload, enforced)
+		if err != nil {
+			return nil, results, err
+		}
+		conflicts := writers.write(convention, changedPaths(changes))
+		if exclusive := exclusiveConflicts(conflicts); len(exclusive) != 0 {
+			err := &ConflictError{Convention: convention.Name, Conflicts: exclusive}
+			log.Error(err, "convention wrote exclusive fields of another convention", "convention", convention.Name)
+			return nil, results, err
+		}
+		if len(conflicts) != 0 {
+			log.Info("convention overwrote fields of other conventions", "convention", convention.Name, "conflicts", conflicts)
+		}
+		workloadDiff := cmp.Diff(workload, enforced, cmpopts.EquateEmpty())
 		log.Info("applied convention", "diff", workloadDiff, "convention", convention.Name)
+		if len(conventionResp.Status.Warnings) != 0 {
+			log.Info("convention reported warnings", "convention", convention.Name, "warnings", conventionResp.Status.Warnings)
+		}
+		results = append(results, ConventionResult{Name: convention.Name, StrippedPaths: strippedPaths, Warnings: conventionResp.Status.Warnings, Conflicts: conflicts, Changes: changes})
 
-		workload = &conventionResp.Status.Template // update pod spec before calling another webhook
+		workload = enforced // update pod spec before calling another webhook
 
 		for _, appliedConvention := range conventionResp.Status.AppliedConventions {
 			labelWithPrefix := fmt.Sprintf("%s/%s", convention.Name, appliedConvention)
@@ -134,5 +314,63 @@ func (c *Conventions) Apply(ctx context.Context,
 		}
 		workload.Annotations[conventionsv1alpha1.AppliedConventionsAnnotationKey] = strings.Join(appliedConventions, "\n")
 	}
-	return workload, nil
+	return workload, results, nil
+}
+
+// Validate calls each validating convention with the template, after the mutating conventions
+// are applied. A result is returned for each convention called, the template is denied when any
+// of the results have denials.
+func (c *Conventions) Validate(ctx context.Context,
+	parent *conventionsv1alpha1.PodIntent,
+	workload *corev1.PodTemplateSpec,
+	wc WebhookConfig,
+	rc RegistryConfig,
+) ([]ConventionResult, error) {
+	log := logr.FromContextOrDiscard(ctx)
+	results := []ConventionResult{}
+	if len(*c) == 0 {
+		return results, nil
+	}
+	// validating conventions do not change the template, the images are resolved once
+	imageConfigList, err := rc.ResolveImageMetadata(ctx, workload)
+	if err != nil {
+		log.Error(err, "fetching metadata for Images failed")
+		return results, fmt.Errorf("failed to fetch metadata for Images: %w", err)
+	}
+	for _, convention := range *c {
+		conventionRequestObj := &webhookv1alpha1.PodConventionContext{
+			ObjectMeta: conventionContextMetadata(parent, convention),
+			Spec: webhookv1alpha1.PodConventionContextSpec{
+				ImageConfig:         convention.FilterImageConfig(imageConfigList),
+				Template:            *workload,
+				Params:              convention.Params,
+				Resources:           convention.Resources,
+				ExcludedConventions: convention.ExcludedConventions,
+			},
+		}
+		conventionResp, err := convention.Apply(ctx, conventionRequestObj, wc)
+		if err != nil {
+			log.Error(err, "failed to validate with convention", "Convention", convention)
+			return results, fmt.Errorf("failed to validate with convention with name %s: %w", convention.Name, err)
+		}
+		validation := conventionResp.Status.Validation
+		if validation == nil {
+			err := &ClassifiedError{Class: WebhookInvalidResponseClass, Err: fmt.Errorf("response of validating convention %s is missing the validation", convention.Name)}
+			log.Error(err, "invalid response from validating convention", "Convention", convention)
+			return results, err
+		}
+		if len(conventionResp.Status.Warnings) != 0 {
+			log.Info("convention reported warnings", "convention", convention.Name, "warnings", conventionResp.Status.Warnings)
+		}
+		result := ConventionResult{Name: convention.Name, Warnings: conventionResp.Status.Warnings}
+		if !validation.Allowed {
+			result.Denials = validation.Reasons
+			if len(result.Denials) == 0 {
+				result.Denials = []webhookv1alpha1.ValidationReason{{Message: "denied without a reason"}}
+			}
+			log.Info("convention denied the template", "convention", convention.Name, "reasons", result.Denials)
+		}
+		results = append(results, result)
+	}
+	return results, nil
 }
@@ -18,12 +18,15 @@ package binding_test
 
 import (
 	"context"
+	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"path"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -37,6 +40,7 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	webhooktesting "k8s.io/apiserver/pkg/admission/plugin/webhook/testing"
 	"k8s.io/utils/pointer"
@@ -44,6 +48,7 @@ import (
 	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
 	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
 	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding/fake"
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
 )
 
 var (
@@ -74,6 +79,7 @@ func TestConventionFilter(t *testing.T) {
 		name            string
 		input           []binding.Convention
 		collectedLabels map[string]labels.Set
+		selection       *conventionsv1alpha1.PodIntentConventions
 		expects         []binding.Convention
 		expectErr       bool
 	}{{
@@ -164,13 +170,78 @@ func TestConventionFilter(t *testing.T) {
 				}},
 			}},
 			expectErr: true,
+		}, {
+			name: "excluded conventions",
+			collectedLabels: map[string]labels.Set{
+				"PodTemplateSpec": map[string]string{},
+				"PodIntent":       map[string]string{},
+			},
+			selection: &conventionsv1alpha1.PodIntentConventions{
+				Exclude: []string{"spring-boot", "security/run-as-non-root", "audit/labels", "audit"},
+			},
+			input: []binding.Convention{{
+				Name: "spring-boot",
+			}, {
+				Name: "security",
+			}, {
+				Name:      "audit",
+				Mandatory: true,
+			}},
+			expects: []binding.Convention{{
+				Name:      "audit",
+				Mandatory: true,
+			}, {
+				Name:                "security",
+				ExcludedConventions: []string{"run-as-non-root"},
+			}},
+		}, {
+			name: "included conventions",
+			collectedLabels: map[string]labels.Set{
+				"PodTemplateSpec": map[string]string{},
+				"PodIntent":       map[string]string{},
+			},
+			selection: &conventionsv1alpha1.PodIntentConventions{
+				Include: []string{"security"},
+			},
+			input: []binding.Convention{{
+				Name: "spring-boot",
+			}, {
+				Name: "security",
+			}, {
+				Name:      "audit",
+				Mandatory: true,
+			}},
+			expects: []binding.Convention{{
+				Name:      "audit",
+				Mandatory: true,
+			}, {
+				Name: "security",
+			}},
+		}, {
+			name: "mandatory conventions must match",
+			collectedLabels: map[string]labels.Set{
+				"PodTemplateSpec": map[string]string{},
+				"PodIntent":       map[string]string{},
+			},
+			selection: &conventionsv1alpha1.PodIntentConventions{
+				Include: []string{"audit"},
+			},
+			input: []binding.Convention{{
+				Name:           "audit",
+				Mandatory:      true,
+				SelectorTarget: "PodTemplateSpec",
+				Selectors: []metav1.LabelSelector{{
+					MatchLabels: map[string]string{"foo": "bar"},
+				}},
+			}},
+			expects: nil,
 		}}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			var actual, expects binding.Conventions
 			actual = test.input
 			expects = test.expects
-			filteredConventions, err := actual.FilterAndSort(test.collectedLabels)
+			filteredConventions, err := actual.FilterAndSort(test.collectedLabels, test.selection)
 			if err == nil && test.expectErr {
 				t.Error("expected error but got none.")
 			}
@@ -186,9 +257,10 @@ func TestConventionFilter(t *testing.T) {
 
 func TestConventionOrder(t *testing.T) {
 	tests := []struct {
-		name    string
-		input   []binding.Convention
-		expects []binding.Convention
+		name      string
+		input     []binding.Convention
+		expects   []binding.Convention
+		expectErr bool
 	}{{
 		name: "same name, different priority",
 		input: []binding.Convention{{
@@ -249,12 +321,111 @@ func TestConventionOrder(t *testing.T) {
 			Name:     "xyz",
 			Priority: conventionsv1alpha1.NormalPriority,
 		}},
+	}, {
+		name: "same priority, different order",
+		input: []binding.Convention{{
+			Name:     "abc",
+			Priority: conventionsv1alpha1.NormalPriority,
+			Order:    10,
+		}, {
+			Name:     "xyz",
+			Priority: conventionsv1alpha1.NormalPriority,
+		}, {
+			Name:     "def",
+			Priority: conventionsv1alpha1.EarlyPriority,
+			Order:    20,
+		}, {
+			Name:     "mno",
+			Priority: conventionsv1alpha1.NormalPriority,
+			Order:    -10,
+		}},
+		expects: []binding.Convention{{
+			Name:     "def",
+			Priority: conventionsv1alpha1.EarlyPriority,
+			Order:    20,
+		}, {
+			Name:     "mno",
+			Priority: conventionsv1alpha1.NormalPriority,
+			Order:    -10,
+		}, {
+			Name:     "xyz",
+			Priority: conventionsv1alpha1.NormalPriority,
+		}, {
+			Name:     "abc",
+			Priority: conventionsv1alpha1.NormalPriority,
+			Order:    10,
+		}},
+	}, {
+		name: "run after and before",
+		input: []binding.Convention{{
+			Name:     "abc",
+			Priority: conventionsv1alpha1.NormalPriority,
+			RunAfter: []string{"xyz"},
+		}, {
+			Name:     "def",
+			Priority: conventionsv1alpha1.NormalPriority,
+		}, {
+			Name:      "xyz",
+			Priority:  conventionsv1alpha1.LatePriority,
+			RunBefore: []string{"def"},
+		}},
+		expects: []binding.Convention{{
+			Name:      "xyz",
+			Priority:  conventionsv1alpha1.LatePriority,
+			RunBefore: []string{"def"},
+		}, {
+			Name:     "abc",
+			Priority: conventionsv1alpha1.NormalPriority,
+			RunAfter: []string{"xyz"},
+		}, {
+			Name:     "def",
+			Priority: conventionsv1alpha1.NormalPriority,
+		}},
+	}, {
+		name: "references to conventions not applied are ignored",
+		input: []binding.Convention{{
+			Name:     "abc",
+			Priority: conventionsv1alpha1.NormalPriority,
+			RunAfter: []string{"missing"},
+		}, {
+			Name:      "xyz",
+			Priority:  conventionsv1alpha1.EarlyPriority,
+			RunBefore: []string{"missing"},
+		}},
+		expects: []binding.Convention{{
+			Name:      "xyz",
+			Priority:  conventionsv1alpha1.EarlyPriority,
+			RunBefore: []string{"missing"},
+		}, {
+			Name:     "abc",
+			Priority: conventionsv1alpha1.NormalPriority,
+			RunAfter: []string{"missing"},
+		}},
+	}, {
+		name: "cycle",
+		input: []binding.Convention{{
+			Name:     "abc",
+			Priority: conventionsv1alpha1.NormalPriority,
+			RunAfter: []string{"xyz"},
+		}, {
+			Name:     "def",
+			Priority: conventionsv1alpha1.NormalPriority,
+		}, {
+			Name:      "xyz",
+			Priority:  conventionsv1alpha1.NormalPriority,
+			RunAfter:  []string{"def"},
+			RunBefore: []string{"def"},
+		}},
+		expectErr: true,
 	}}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			var actual, expects binding.Conventions
 			actual = test.input
-			sortedConventions := actual.Sort()
+			sortedConventions, err := actual.Sort()
+			if (err != nil) != test.expectErr {
+				t.Errorf("Sort() expected error %v, got %v", test.expectErr, err)
+			}
 			expects = test.expects
 
 			if diff := cmp.Diff(expects, sortedConventions); diff != "" {
@@ -569,7 +740,7 @@ func TestConventionApply(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			var input binding.Conventions
 			input = append(input, test.convetions...)
-			updatedSpec, err := input.Apply(context.Background(), test.workload, wc, rc)
+			updatedSpec, _, err := input.Apply(context.Background(), test.workload, wc, rc)
 			if (err != nil) != test.shouldErr {
 				t.Errorf("Apply() error = %v, ExpectErr %v", err, test.shouldErr)
 			}
@@ -581,6 +752,505 @@ func TestConventionApply(t *testing.T) {
 
 }
 
+func TestConventionApplyWarnings(t *testing.T) {
+	testServer, caCert, err := fake.NewFakeConventionServer()
+	if err != nil {
+		t.Fatalf("unable to create convention server: %v", err)
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	serverURL, err := url.ParseRequestURI(testServer.URL)
+	if err != nil {
+		t.Fatalf("this should never happen? %v", err)
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceResolver:  fake.NewStubServiceResolver(*serverURL),
+	}
+	conventions := binding.Conventions{{
+		Name: "warning-conventions",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: "default",
+				Name:      "webhook-test",
+				Path:      pointer.String("warning"),
+			},
+			CABundle: caCert,
+		},
+	}, {
+		Name: "label-conventions",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: "default",
+				Name:      "webhook-test",
+				Path:      pointer.String("labelonly"),
+			},
+			CABundle: caCert,
+		},
+	}}
+	workload := &conventionsv1alpha1.PodIntent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-template",
+			Namespace: "test-namespace",
+		},
+	}
+
+	_, results, err := conventions.Apply(context.Background(), workload, wc, binding.RegistryConfig{})
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	expected := []binding.ConventionResult{
+		{Name: "warning-conventions", Warnings: []string{"port 8080 is in use, using 8081"}},
+		{Name: "label-conventions"},
+	}
+	if diff := cmp.Diff(expected, results); diff != "" {
+		t.Errorf("Apply() (-expected, + actual) %v", diff)
+	}
+}
+
+func TestConventionApplyMetadata(t *testing.T) {
+	testServer, caCert, err := fake.NewFakeConventionServer()
+	if err != nil {
+		t.Fatalf("unable to create convention server: %v", err)
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	serverURL, err := url.ParseRequestURI(testServer.URL)
+	if err != nil {
+		t.Fatalf("this should never happen? %v", err)
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceResolver:  fake.NewStubServiceResolver(*serverURL),
+	}
+	conventions := binding.Conventions{{
+		Name: "metadata-conventions",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: "default",
+				Name:      "webhook-test",
+				Path:      pointer.String("metadata"),
+			},
+			CABundle: caCert,
+		},
+	}}
+	workload := &conventionsv1alpha1.PodIntent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-template",
+			Namespace: "test-namespace",
+			Labels:    map[string]string{"app": "petclinic"},
+			Annotations: map[string]string{
+				"team":                             "spring",
+				corev1.LastAppliedConfigAnnotation: "{}",
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "carto.run/v1alpha1",
+				Kind:       "Workload",
+				Name:       "petclinic",
+			}},
+		},
+	}
+
+	updated, _, err := conventions.Apply(context.Background(), workload, wc, binding.RegistryConfig{})
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	expected := strings.Join([]string{
+		"metadata-conventions/my-template-metadata-conventions",
+		"metadata-conventions/test-namespace",
+		"metadata-conventions/petclinic",
+		"metadata-conventions/spring",
+		"metadata-conventions/Workload/petclinic",
+	}, "\n")
+	if actual := updated.Annotations[conventionsv1alpha1.AppliedConventionsAnnotationKey]; actual != expected {
+		t.Errorf("Apply() expected applied conventions %q, got %q", expected, actual)
+	}
+	if _, ok := workload.Annotations[corev1.LastAppliedConfigAnnotation]; !ok {
+		t.Errorf("Apply() must not modify the PodIntent's annotations")
+	}
+}
+
+func TestConventionApplyParams(t *testing.T) {
+	testServer, caCert, err := fake.NewFakeConventionServer()
+	if err != nil {
+		t.Fatalf("unable to create convention server: %v", err)
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	serverURL, err := url.ParseRequestURI(testServer.URL)
+	if err != nil {
+		t.Fatalf("this should never happen? %v", err)
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceResolver:  fake.NewStubServiceResolver(*serverURL),
+	}
+	conventions := binding.Conventions{{
+		Name: "params-conventions",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: "default",
+				Name:      "webhook-test",
+				Path:      pointer.String("params"),
+			},
+			CABundle: caCert,
+		},
+		Params: map[string]string{
+			"jvm.memory-ratio":   "0.75",
+			"probes.health-path": "/livez",
+		},
+	}}
+	workload := &conventionsv1alpha1.PodIntent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-template",
+			Namespace: "test-namespace",
+		},
+	}
+
+	updated, _, err := conventions.Apply(context.Background(), workload, wc, binding.RegistryConfig{})
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	expected := strings.Join([]string{
+		"params-conventions/jvm.memory-ratio=0.75",
+		"params-conventions/probes.health-path=/livez",
+	}, "\n")
+	if actual := updated.Annotations[conventionsv1alpha1.AppliedConventionsAnnotationKey]; actual != expected {
+		t.Errorf("Apply() expected applied conventions %q, got %q", expected, actual)
+	}
+}
+
+func TestConventionApplyExcludedConventions(t *testing.T) {
+	testServer, caCert, err := fake.NewFakeConventionServer()
+	if err != nil {
+		t.Fatalf("unable to create convention server: %v", err)
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	serverURL, err := url.ParseRequestURI(testServer.URL)
+	if err != nil {
+		t.Fatalf("this should never happen? %v", err)
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceResolver:  fake.NewStubServiceResolver(*serverURL),
+	}
+	conventions := binding.Conventions{{
+		Name: "security-conventions",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: "default",
+				Name:      "webhook-test",
+				Path:      pointer.String("excluded"),
+			},
+			CABundle: caCert,
+		},
+		ExcludedConventions: []string{"run-as-non-root", "read-only-root"},
+	}}
+	workload := &conventionsv1alpha1.PodIntent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-template",
+			Namespace: "test-namespace",
+		},
+	}
+
+	updated, _, err := conventions.Apply(context.Background(), workload, wc, binding.RegistryConfig{})
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	expected := strings.Join([]string{
+		"security-conventions/run-as-non-root",
+		"security-conventions/read-only-root",
+	}, "\n")
+	if actual := updated.Annotations[conventionsv1alpha1.AppliedConventionsAnnotationKey]; actual != expected {
+		t.Errorf("Apply() expected applied conventions %q, got %q", expected, actual)
+	}
+}
+
+func TestConventionApplyConflicts(t *testing.T) {
+	testServer, caCert, err := fake.NewFakeConventionServer()
+	if err != nil {
+		t.Fatalf("unable to create convention server: %v", err)
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	serverURL, err := url.ParseRequestURI(testServer.URL)
+	if err != nil {
+		t.Fatalf("this should never happen? %v", err)
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceResolver:  fake.NewStubServiceResolver(*serverURL),
+	}
+	convention := func(name, path string, exclusiveFields ...string) binding.Convention {
+		return binding.Convention{
+			Name: name,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{
+					Namespace: "default",
+					Name:      "webhook-test",
+					Path:      pointer.String(path),
+				},
+				CABundle: caCert,
+			},
+			ExclusiveFields: exclusiveFields,
+		}
+	}
+	workload := &conventionsv1alpha1.PodIntent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-template",
+			Namespace: "test-namespace",
+		},
+		Spec: conventionsv1alpha1.PodIntentSpec{
+			Template: conventionsv1alpha1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "workload"}},
+				},
+			},
+		},
+	}
+
+	ownerA := []binding.FieldChange{{
+		Path:  ".metadata.labels.owner",
+		After: json.RawMessage(`"my-template-owner-a"`),
+	}}
+	ownerB := []binding.FieldChange{{
+		Path:   ".metadata.labels.owner",
+		Before: json.RawMessage(`"my-template-owner-a"`),
+		After:  json.RawMessage(`"my-template-owner-b"`),
+	}}
+	probeA := []binding.FieldChange{{
+		Path:  ".spec.containers[workload].livenessProbe.httpGet.path",
+		After: json.RawMessage(`"/my-template-probe-a"`),
+	}, {
+		Path:  ".spec.containers[workload].livenessProbe.httpGet.port",
+		After: json.RawMessage(`0`),
+	}}
+
+	tests := []struct {
+		name        string
+		conventions binding.Conventions
+		expected    []binding.ConventionResult
+		expectedErr *binding.ConflictError
+	}{{
+		name:        "separate fields",
+		conventions: binding.Conventions{convention("owner-a", "owner"), convention("probe-a", "probe")},
+		expected:    []binding.ConventionResult{{Name: "owner-a", Changes: ownerA}, {Name: "probe-a", Changes: probeA}},
+	}, {
+		name:        "same field",
+		conventions: binding.Conventions{convention("owner-a", "owner"), convention("probe-a", "probe"), convention("owner-b", "owner")},
+		expected: []binding.ConventionResult{{Name: "owner-a", Changes: ownerA}, {Name: "probe-a", Changes: probeA}, {
+			Name: "owner-b",
+			Conflicts: []binding.Conflict{{
+				Path:       ".metadata.labels.owner",
+				Convention: "owner-a",
+			}},
+			Changes: ownerB,
+		}},
+	}, {
+		name:        "exclusive to the earlier convention",
+		conventions: binding.Conventions{convention("probe-a", "probe", ".spec.containers[*].livenessProbe"), convention("probe-b", "probe")},
+		expected:    []binding.ConventionResult{{Name: "probe-a", Changes: probeA}},
+		expectedErr: &binding.ConflictError{
+			Convention: "probe-b",
+			Conflicts: []binding.Conflict{{
+				Path:       ".spec.containers[workload].livenessProbe.httpGet.path",
+				Convention: "probe-a",
+				Exclusive:  true,
+			}},
+		},
+	}, {
+		name:        "exclusive to the later convention",
+		conventions: binding.Conventions{convention("owner-a", "owner"), convention("owner-b", "owner", ".metadata.labels")},
+		expected:    []binding.ConventionResult{{Name: "owner-a", Changes: ownerA}},
+		expectedErr: &binding.ConflictError{
+			Convention: "owner-b",
+			Conflicts: []binding.Conflict{{
+				Path:       ".metadata.labels.owner",
+				Convention: "owner-a",
+				Exclusive:  true,
+			}},
+		},
+	}, {
+		name:        "exclusive to another field",
+		conventions: binding.Conventions{convention("owner-a", "owner", ".spec.containers[*].livenessProbe"), convention("owner-b", "owner")},
+		expected: []binding.ConventionResult{{Name: "owner-a", Changes: ownerA}, {
+			Name: "owner-b",
+			Conflicts: []binding.Conflict{{
+				Path:       ".metadata.labels.owner",
+				Convention: "owner-a",
+			}},
+			Changes: ownerB,
+		}},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, results, err := test.conventions.Apply(context.Background(), workload.DeepCopy(), wc, binding.RegistryConfig{})
+			if test.expectedErr != nil {
+				var conflictErr *binding.ConflictError
+				if !errors.As(err, &conflictErr) {
+					t.Fatalf("Apply() expected conflict error, got %v", err)
+				}
+				if diff := cmp.Diff(test.expectedErr, conflictErr); diff != "" {
+					t.Errorf("Apply() error (-expected, +actual): %s", diff)
+				}
+			} else if err != nil {
+				t.Fatalf("Apply() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.expected, results); diff != "" {
+				t.Errorf("Apply() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConventionApplyResources(t *testing.T) {
+	testServer, caCert, err := fake.NewFakeConventionServer()
+	if err != nil {
+		t.Fatalf("unable to create convention server: %v", err)
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	serverURL, err := url.ParseRequestURI(testServer.URL)
+	if err != nil {
+		t.Fatalf("this should never happen? %v", err)
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceResolver:  fake.NewStubServiceResolver(*serverURL),
+	}
+	limitRange := unstructured.Unstructured{}
+	limitRange.SetAPIVersion("v1")
+	limitRange.SetKind("LimitRange")
+	limitRange.SetName("defaults")
+	secret := unstructured.Unstructured{}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetName("binding-db")
+	conventions := binding.Conventions{{
+		Name: "resources-conventions",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: "default",
+				Name:      "webhook-test",
+				Path:      pointer.String("resources"),
+			},
+			CABundle: caCert,
+		},
+		Resources: []unstructured.Unstructured{limitRange, secret},
+	}}
+	workload := &conventionsv1alpha1.PodIntent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-template",
+			Namespace: "test-namespace",
+		},
+	}
+
+	updated, _, err := conventions.Apply(context.Background(), workload, wc, binding.RegistryConfig{})
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	expected := strings.Join([]string{
+		"resources-conventions/LimitRange/defaults",
+		"resources-conventions/Secret/binding-db",
+	}, "\n")
+	if actual := updated.Annotations[conventionsv1alpha1.AppliedConventionsAnnotationKey]; actual != expected {
+		t.Errorf("Apply() expected applied conventions %q, got %q", expected, actual)
+	}
+}
+
+func TestConventionValidate(t *testing.T) {
+	testServer, caCert, err := fake.NewFakeConventionServer()
+	if err != nil {
+		t.Fatalf("unable to create convention server: %v", err)
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	serverURL, err := url.ParseRequestURI(testServer.URL)
+	if err != nil {
+		t.Fatalf("this should never happen? %v", err)
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceResolver:  fake.NewStubServiceResolver(*serverURL),
+	}
+	validator := func(name, path string) binding.Convention {
+		return binding.Convention{
+			Name:       name,
+			Validating: true,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{
+					Namespace: "default",
+					Name:      "webhook-test",
+					Path:      pointer.String(path),
+				},
+				CABundle: caCert,
+			},
+		}
+	}
+	workload := &conventionsv1alpha1.PodIntent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-template",
+			Namespace: "test-namespace",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		conventions   binding.Conventions
+		expected      []binding.ConventionResult
+		expectedClass binding.ErrorClass
+	}{{
+		name:        "no validating conventions",
+		conventions: binding.Conventions{},
+		expected:    []binding.ConventionResult{},
+	}, {
+		name:        "allowed",
+		conventions: binding.Conventions{validator("allow-policy", "allow")},
+		expected:    []binding.ConventionResult{{Name: "allow-policy"}},
+	}, {
+		name:        "denied",
+		conventions: binding.Conventions{validator("allow-policy", "allow"), validator("deny-policy", "deny")},
+		expected: []binding.ConventionResult{{Name: "allow-policy"}, {
+			Name: "deny-policy",
+			Denials: []webhookv1alpha1.ValidationReason{{
+				Message: "privileged containers are not allowed",
+				Field:   "spec.containers[0].securityContext.privileged",
+			}},
+		}},
+	}, {
+		name:          "missing validation",
+		conventions:   binding.Conventions{validator("allow-policy", "allow"), validator("mutating", "warning")},
+		expected:      []binding.ConventionResult{{Name: "allow-policy"}},
+		expectedClass: binding.WebhookInvalidResponseClass,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results, err := test.conventions.Validate(context.Background(), workload, &corev1.PodTemplateSpec{}, wc, binding.RegistryConfig{})
+			if test.expectedClass != "" {
+				if err == nil {
+					t.Fatalf("Validate() expected error")
+				}
+				if class := binding.ClassOf(err); class != test.expectedClass {
+					t.Errorf("Validate() expected error class %q, got %q: %v", test.expectedClass, class, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Validate() unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(test.expected, results); diff != "" {
+				t.Errorf("Validate() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestNilRegistryConfig(t *testing.T) {
 	testServer, caCert, err := fake.NewFakeConventionServer()
 	if err != nil {
@@ -627,7 +1297,7 @@ func TestNilRegistryConfig(t *testing.T) {
 		},
 	}
 
-	if _, err = input.Apply(context.Background(), &workload, wc, rc); err == nil {
+	if _, _, err = input.Apply(context.Background(), &workload, wc, rc); err == nil {
 		t.Error("Apply() expected error but got nil")
 	}
 }
@@ -766,7 +1436,7 @@ func TestRepositoryConfigWithAdditionalCert(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			var input binding.Conventions
 			input = append(input, test.convetions...)
-			updatedSpec, err := input.Apply(context.Background(), test.workload, wc, rc)
+			updatedSpec, _, err := input.Apply(context.Background(), test.workload, wc, rc)
 			if (err != nil) != test.shouldErr {
 				t.Errorf("Apply() error = %v, ExpectErr %v", err, test.shouldErr)
 			}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+)
+
+// ErrorClass groups failures that are retried the same way. The class is used as the reason of
+// the ConventionsApplied condition.
+type ErrorClass string
+
+const (
+	// TransientErrorClass is a network failure or timeout reaching a convention server or registry.
+	TransientErrorClass ErrorClass = "TransientError"
+	// WebhookServerErrorClass is a 5xx response from a convention server.
+	WebhookServerErrorClass ErrorClass = "WebhookServerError"
+	// WebhookInvalidResponseClass is a 4xx response from a convention server, or a response that
+	// could not be applied to the workload. Retrying without a change to the convention is
+	// unlikely to succeed.
+	WebhookInvalidResponseClass ErrorClass = "WebhookInvalidResponse"
+	// RegistryAuthErrorClass is a failure to authenticate with, or be authorized by, an image
+	// registry.
+	RegistryAuthErrorClass ErrorClass = "RegistryAuthFailed"
+	// RegistryNotFoundErrorClass is an image, or its repository, that does not exist in the
+	// registry.
+	RegistryNotFoundErrorClass ErrorClass = "ImageNotFound"
+	// ServiceAccountTokenErrorClass is a failure to request a token for a convention that
+	// authenticates the controller with service account tokens, other than a transient failure
+	// of the API server. The controller's service account, or its permissions, need to change.
+	ServiceAccountTokenErrorClass ErrorClass = "ServiceAccountTokenFailed"
+	// ConventionRejectedClass is a convention that reported a failure that is not retryable. The
+	// workload is not retried until it, or the convention, changes.
+	ConventionRejectedClass ErrorClass = "ConventionRejected"
+	// UnknownErrorClass is any other failure.
+	UnknownErrorClass ErrorClass = "UnknownError"
+)
+
+// ClassifiedError is an error annotated with its class.
+type ClassifiedError struct {
+	Class ErrorClass
+	Err   error
+}
+
+func (e *ClassifiedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ClassifiedError) Unwrap() error {
+	return e.Err
+}
+
+// ConventionError is a failure described by a convention server in its response.
+type ConventionError struct {
+	Response webhookv1alpha1.ConventionError
+}
+
+func (e *ConventionError) Error() string {
+	return e.Response.Error()
+}
+
+// Retryable indicates the convention may succeed if the request is retried without change.
+func (e *ConventionError) Retryable() bool {
+	return e.Response.Retryable
+}
+
+// ClassOf returns the class of the error. When the metadata for several images could not be
+// resolved, the class of the image failure least likely to resolve itself wins.
+func ClassOf(err error) ErrorClass {
+	if err == nil {
+		return ""
+	}
+	var imageErr ImageError
+	if errors.As(err, &imageErr) {
+		class := UnknownErrorClass
+		for _, err := range imageErr {
+			if c := ClassOf(err); imageErrorClassPrecedence[c] > imageErrorClassPrecedence[class] {
+				class = c
+			}
+		}
+		return class
+	}
+	var classifiedErr *ClassifiedError
+	if errors.As(err, &classifiedErr) {
+		return classifiedErr.Class
+	}
+	if isTransient(err) {
+		return TransientErrorClass
+	}
+	return UnknownErrorClass
+}
+
+var imageErrorClassPrecedence = map[ErrorClass]int{
+	UnknownErrorClass:          0,
+	TransientErrorClass:        1,
+	RegistryNotFoundErrorClass: 2,
+	RegistryAuthErrorClass:     3,
+}
+
+// classifyWebhookError classifies a failed request to a convention server.
+func classifyWebhookError(err error) error {
+	var status apierrors.APIStatus
+	if errors.As(err, &status) {
+		switch code := int(status.Status().Code); {
+		case code == http.StatusTooManyRequests:
+			return &ClassifiedError{Class: TransientErrorClass, Err: err}
+		case code >= 500:
+			return &ClassifiedError{Class: WebhookServerErrorClass, Err: err}
+		case code >= 400:
+			return &ClassifiedError{Class: WebhookInvalidResponseClass, Err: err}
+		}
+	}
+	// the request never received a response
+	return &ClassifiedError{Class: TransientErrorClass, Err: err}
+}
+
+// classifyTokenError classifies a failure to request a service account token from the API
+// server.
+func classifyTokenError(err error) error {
+	if isTransient(err) || apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) || apierrors.IsTooManyRequests(err) ||
+		apierrors.IsInternalError(err) || apierrors.IsServiceUnavailable(err) {
+		return &ClassifiedError{Class: TransientErrorClass, Err: err}
+	}
+	return &ClassifiedError{Class: ServiceAccountTokenErrorClass, Err: err}
+}
+
+// classifyRegistryError classifies a failure to resolve an image from a registry.
+func classifyRegistryError(err error) error {
+	var classifiedErr *ClassifiedError
+	if errors.As(err, &classifiedErr) {
+		return err
+	}
+	var transportErr *transport.Error
+	if errors.As(err, &transportErr) {
+		for _, diagnostic := range transportErr.Errors {
+			switch diagnostic.Code {
+			case transport.UnauthorizedErrorCode, transport.DeniedErrorCode:
+				return &ClassifiedError{Class: RegistryAuthErrorClass, Err: err}
+			case transport.ManifestUnknownErrorCode, transport.NameUnknownErrorCode, transport.BlobUnknownErrorCode:
+				return &ClassifiedError{Class: RegistryNotFoundErrorClass, Err: err}
+			}
+		}
+		switch code := transportErr.StatusCode; {
+		case code == http.StatusUnauthorized, code == http.StatusForbidden:
+			return &ClassifiedError{Class: RegistryAuthErrorClass, Err: err}
+		case code == http.StatusNotFound:
+			return &ClassifiedError{Class: RegistryNotFoundErrorClass, Err: err}
+		case code == http.StatusTooManyRequests, code >= 500:
+			return &ClassifiedError{Class: TransientErrorClass, Err: err}
+		}
+	}
+	if isTransient(err) {
+		return &ClassifiedError{Class: TransientErrorClass, Err: err}
+	}
+	return err
+}
+
+func isTransient(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
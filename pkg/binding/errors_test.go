@@ -0,0 +1,67 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+)
+
+func TestClassOf(t *testing.T) {
+	authErr := &binding.ClassifiedError{Class: binding.RegistryAuthErrorClass, Err: fmt.Errorf("unauthorized")}
+	notFoundErr := &binding.ClassifiedError{Class: binding.RegistryNotFoundErrorClass, Err: fmt.Errorf("manifest unknown")}
+
+	tests := []struct {
+		name    string
+		err     error
+		expects binding.ErrorClass
+	}{{
+		name:    "no error",
+		err:     nil,
+		expects: "",
+	}, {
+		name:    "unclassified",
+		err:     fmt.Errorf("boom"),
+		expects: binding.UnknownErrorClass,
+	}, {
+		name:    "deadline exceeded",
+		err:     fmt.Errorf("calling convention: %w", context.DeadlineExceeded),
+		expects: binding.TransientErrorClass,
+	}, {
+		name:    "wrapped",
+		err:     fmt.Errorf("failed to apply convention: %w", notFoundErr),
+		expects: binding.RegistryNotFoundErrorClass,
+	}, {
+		name: "images",
+		err: fmt.Errorf("failed to fetch metadata for Images: %w", binding.ImageError{
+			"ubuntu":  notFoundErr,
+			"private": authErr,
+			"other":   fmt.Errorf("boom"),
+		}),
+		expects: binding.RegistryAuthErrorClass,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := binding.ClassOf(test.err); actual != test.expects {
+				t.Errorf("ClassOf() expected %q, got %q", test.expects, actual)
+			}
+		})
+	}
+}
@@ -22,9 +22,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -232,6 +234,72 @@ func webhookHandler(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		validResponse.Status.AppliedConventions = []string{defaultLabel, "path/addonlylabel"}
 		json.NewEncoder(w).Encode(validResponse)
+	case "/metadata":
+		w.Header().Set("Content-Type", "application/json")
+		validResponse.Status.AppliedConventions = []string{reqObj.Name, reqObj.Namespace, reqObj.Labels["app"], reqObj.Annotations["team"]}
+		for _, ref := range reqObj.OwnerReferences {
+			validResponse.Status.AppliedConventions = append(validResponse.Status.AppliedConventions, fmt.Sprintf("%s/%s", ref.Kind, ref.Name))
+		}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/params":
+		w.Header().Set("Content-Type", "application/json")
+		for _, key := range slices.Sorted(maps.Keys(reqObj.Spec.Params)) {
+			validResponse.Status.AppliedConventions = append(validResponse.Status.AppliedConventions, fmt.Sprintf("%s=%s", key, reqObj.Spec.Params[key]))
+		}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/resources":
+		w.Header().Set("Content-Type", "application/json")
+		for _, r := range reqObj.Spec.Resources {
+			validResponse.Status.AppliedConventions = append(validResponse.Status.AppliedConventions, fmt.Sprintf("%s/%s", r.GetKind(), r.GetName()))
+		}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/excluded":
+		w.Header().Set("Content-Type", "application/json")
+		validResponse.Status.AppliedConventions = append(validResponse.Status.AppliedConventions, reqObj.Spec.ExcludedConventions...)
+		json.NewEncoder(w).Encode(validResponse)
+	case "/allow":
+		w.Header().Set("Content-Type", "application/json")
+		validResponse.Status.Validation = &webhookv1alpha1.ConventionValidation{Allowed: true}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/deny":
+		w.Header().Set("Content-Type", "application/json")
+		validResponse.Status.Validation = &webhookv1alpha1.ConventionValidation{
+			Allowed: false,
+			Reasons: []webhookv1alpha1.ValidationReason{{
+				Message: "privileged containers are not allowed",
+				Field:   "spec.containers[0].securityContext.privileged",
+			}},
+		}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/panic":
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		validResponse.Status = webhookv1alpha1.PodConventionContextStatus{
+			Error: &webhookv1alpha1.ConventionError{Reason: webhookv1alpha1.ConventionPanicReason, Message: "convention panicked: assignment to entry in nil map"},
+		}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/owner":
+		w.Header().Set("Content-Type", "application/json")
+		if validResponse.Status.Template.Labels == nil {
+			validResponse.Status.Template.Labels = map[string]string{}
+		}
+		validResponse.Status.Template.Labels["owner"] = reqObj.Name
+		json.NewEncoder(w).Encode(validResponse)
+	case "/probe":
+		w.Header().Set("Content-Type", "application/json")
+		for i := range validResponse.Status.Template.Spec.Containers {
+			validResponse.Status.Template.Spec.Containers[i].LivenessProbe = &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{
+					HTTPGet: &corev1.HTTPGetAction{Path: "/" + reqObj.Name},
+				},
+			}
+		}
+		json.NewEncoder(w).Encode(validResponse)
+	case "/warning":
+		w.Header().Set("Content-Type", "application/json")
+		validResponse.Status.AppliedConventions = []string{"path/warning"}
+		validResponse.Status.Warnings = []string{"port 8080 is in use, using 8081"}
+		json.NewEncoder(w).Encode(validResponse)
 	case "/":
 		w.Header().Set("Content-Type", "application/json")
 		validResponse.Status.Template.Spec.Containers = append(validResponse.Status.Template.Spec.Containers, corev1.Container{
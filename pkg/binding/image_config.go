@@ -25,12 +25,17 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/authn"
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/cache"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
@@ -43,11 +48,19 @@ type RegistryConfig struct {
 	Cache      cache.Cache
 	Client     kubernetes.Interface
 	CACertPath string
+	// MaxSBOMFileBytes is the maximum size of a single SBOM file loaded from an image, larger
+	// files are skipped. Zero disables the limit.
+	MaxSBOMFileBytes int64
+	// MaxSBOMImageBytes is the maximum combined size of the SBOM files loaded from an image,
+	// files that would exceed the limit are skipped. Zero disables the limit.
+	MaxSBOMImageBytes int64
 }
 
-type imageError map[string]error
+// ImageError is returned when the metadata for one or more images could not be resolved, keyed
+// by image.
+type ImageError map[string]error
 
-func (e imageError) Error() string {
+func (e ImageError) Error() string {
 	var aggregatedError string
 	for imageName, err := range e {
 		// TODO(shashwathi): best way to aggregate error msgs for all images. tab or new line
@@ -95,9 +108,11 @@ func (rc *RegistryConfig) ResolveImageMetadata(ctx context.Context, template *co
 	var imageErrMap = map[string]error{}
 	for _, image := range images.List() {
 		if image != "" {
+			start := time.Now()
 			imageConfig, err := rc.resolveImageMetadata(ctx, image, name.WeakValidation)
+			observeImageResolution(image, start, err)
 			if err != nil {
-				imageErrMap[image] = err
+				imageErrMap[image] = classifyRegistryError(err)
 				continue
 			}
 			imageConfigList = append(imageConfigList, imageConfig)
@@ -105,20 +120,25 @@ func (rc *RegistryConfig) ResolveImageMetadata(ctx context.Context, template *co
 		}
 	}
 	if len(imageErrMap) > 0 {
-		return imageConfigList, imageError(imageErrMap)
+		return imageConfigList, ImageError(imageErrMap)
 	}
 	// update workload with resolved the image references.
 	updateWithResolvedDigest(template, imageDigest)
 	return imageConfigList, nil
 }
 
-func (rc *RegistryConfig) resolveImageMetadata(ctx context.Context, imageRef string, opts ...name.Option) (webhookv1alpha1.ImageConfig, error) {
+func (rc *RegistryConfig) resolveImageMetadata(ctx context.Context, imageRef string, opts ...name.Option) (_ webhookv1alpha1.ImageConfig, err error) {
+	ctx, span := tracer.Start(ctx, "RegistryConfig.ResolveImageMetadata", trace.WithAttributes(
+		attribute.String("image", imageRef),
+	))
+	defer func() { endSpan(span, err) }()
+
 	ref, resolved, err := resolveTagsToDigest(imageRef, opts...)
 	if err != nil {
 		return webhookv1alpha1.ImageConfig{}, fmt.Errorf("failed to resolve image %q: %v as digest could not be determined from tag provided", imageRef, err)
 	}
 	if rc.Keys == nil {
-		return webhookv1alpha1.ImageConfig{}, fmt.Errorf("registry config keys are not set")
+		return webhookv1alpha1.ImageConfig{}, &ClassifiedError{Class: RegistryAuthErrorClass, Err: fmt.Errorf("registry config keys are not set")}
 	}
 
 	rt, err := rc.transport()
@@ -145,7 +165,7 @@ func (rc *RegistryConfig) resolveImageMetadata(ctx context.Context, imageRef str
 	}
 	var sboms []webhookv1alpha1.BOM
 	if appDiffId != "" {
-		sboms, err = rc.loadSBOMs(image, appDiffId, "cnb-app")
+		sboms, err = rc.loadSBOMs(ctx, image, appDiffId, "cnb-app")
 		if err != nil {
 			return webhookv1alpha1.ImageConfig{}, err
 		}
@@ -167,10 +187,10 @@ func (rc *RegistryConfig) resolveImageMetadata(ctx context.Context, imageRef str
 	}, nil
 }
 
-// transport with systemCA and customCAs (if defined)
+// transport with systemCA and customCAs (if defined), traced requests to the registry
 func (rc *RegistryConfig) transport() (http.RoundTripper, error) {
 	if rc.CACertPath == "" {
-		return remote.DefaultTransport, nil
+		return otelhttp.NewTransport(remote.DefaultTransport), nil
 	}
 
 	transport := remote.DefaultTransport.(*http.Transport).Clone()
@@ -187,7 +207,7 @@ func (rc *RegistryConfig) transport() (http.RoundTripper, error) {
 	}
 
 	transport.TLSClientConfig.RootCAs.AppendCertsFromPEM(additionalCA)
-	return transport, nil
+	return otelhttp.NewTransport(transport), nil
 }
 
 func (rc *RegistryConfig) resolveSBOMDiffId(config *v1.ConfigFile) (string, error) {
@@ -221,7 +241,7 @@ func (rc *RegistryConfig) resolveSBOMDiffId(config *v1.ConfigFile) (string, erro
 	return diffID, nil
 }
 
-func (rc *RegistryConfig) loadSBOMs(image v1.Image, diffID string, prefix string) ([]webhookv1alpha1.BOM, error) {
+func (rc *RegistryConfig) loadSBOMs(ctx context.Context, image v1.Image, diffID string, prefix string) ([]webhookv1alpha1.BOM, error) {
 	hash, err := v1.NewHash(diffID)
 	if err != nil {
 		return nil, err
@@ -235,11 +255,13 @@ func (rc *RegistryConfig) loadSBOMs(image v1.Image, diffID string, prefix string
 		return nil, err
 	}
 	defer tar.Close()
-	return rc.untarSBOMs(tar, prefix)
+	return rc.untarSBOMs(ctx, tar, prefix)
 }
 
-func (rc *RegistryConfig) untarSBOMs(r io.Reader, prefix string) ([]webhookv1alpha1.BOM, error) {
+func (rc *RegistryConfig) untarSBOMs(ctx context.Context, r io.Reader, prefix string) ([]webhookv1alpha1.BOM, error) {
+	log := logr.FromContextOrDiscard(ctx)
 	boms := []webhookv1alpha1.BOM{}
+	var loaded int64
 	tr := tar.NewReader(r)
 	for {
 		header, err := tr.Next()
@@ -252,16 +274,28 @@ func (rc *RegistryConfig) untarSBOMs(r io.Reader, prefix string) ([]webhookv1alp
 		if header.Typeflag != tar.TypeReg {
 			continue
 		}
+		name := fmt.Sprintf("%s:%s", prefix, header.Name)
+		if rc.MaxSBOMFileBytes > 0 && header.Size > rc.MaxSBOMFileBytes {
+			log.Info("skipping SBOM larger than the file limit", "name", name, "size", header.Size, "limit", rc.MaxSBOMFileBytes)
+			continue
+		}
+		if rc.MaxSBOMImageBytes > 0 && loaded+header.Size > rc.MaxSBOMImageBytes {
+			log.Info("skipping SBOM exceeding the image limit", "name", name, "size", header.Size, "limit", rc.MaxSBOMImageBytes)
+			continue
+		}
 
-		raw, err := io.ReadAll(tr)
+		// never trust the reader beyond the size declared in the header
+		raw, err := io.ReadAll(io.LimitReader(tr, header.Size))
 		if err != nil {
 			return nil, err
 		}
+		loaded += int64(len(raw))
 		boms = append(boms, webhookv1alpha1.BOM{
-			Name: fmt.Sprintf("%s:%s", prefix, header.Name),
+			Name: name,
 			Raw:  raw,
 		})
 	}
+	sbomLoadedBytes.Add(float64(loaded))
 	return boms, nil
 }
 
@@ -129,10 +129,13 @@ func TestCreateImageConfigs(t *testing.T) {
 	testCache := cache.NewFilesystemCache(dir)
 
 	tests := []struct {
-		name      string
-		input     *corev1.PodTemplateSpec
-		expects   []webhookv1alpha1.ImageConfig
-		shouldErr bool
+		name              string
+		input             *corev1.PodTemplateSpec
+		maxSBOMFileBytes  int64
+		maxSBOMImageBytes int64
+		expects           []webhookv1alpha1.ImageConfig
+		shouldErr         bool
+		expectsErrClass   binding.ErrorClass
 	}{{
 		name:    "empty pod spec",
 		input:   &corev1.PodTemplateSpec{},
@@ -159,6 +162,48 @@ func TestCreateImageConfigs(t *testing.T) {
 				BOMs:   helloSboms,
 			},
 		},
+	}, {
+		name: "sbom file limit",
+		input: &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "valid",
+						Image: fmt.Sprintf("%s/hello:sbom", u.Host),
+					},
+				},
+			},
+		},
+		maxSBOMFileBytes: 10000,
+		expects: []webhookv1alpha1.ImageConfig{
+			{
+				Image:  fmt.Sprintf("%s/hello:sbom@%s", u.Host, helloSbomImgDigest.String()),
+				Config: *helloSbomImgConfig,
+				BOMs: []webhookv1alpha1.BOM{
+					helloSboms[0], helloSboms[1], helloSboms[2], helloSboms[3], helloSboms[5], helloSboms[6],
+				},
+			},
+		},
+	}, {
+		name: "sbom image limit",
+		input: &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  "valid",
+						Image: fmt.Sprintf("%s/hello:sbom", u.Host),
+					},
+				},
+			},
+		},
+		maxSBOMImageBytes: 3100,
+		expects: []webhookv1alpha1.ImageConfig{
+			{
+				Image:  fmt.Sprintf("%s/hello:sbom@%s", u.Host, helloSbomImgDigest.String()),
+				Config: *helloSbomImgConfig,
+				BOMs:   helloSboms[0:3],
+			},
+		},
 	}, {
 		name: "containers",
 		input: &corev1.PodTemplateSpec{
@@ -229,7 +274,8 @@ func TestCreateImageConfigs(t *testing.T) {
 				},
 			},
 		},
-		shouldErr: true,
+		shouldErr:       true,
+		expectsErrClass: binding.RegistryNotFoundErrorClass,
 	}, {
 		name: "mix of valid and invalid init containers",
 		input: &corev1.PodTemplateSpec{
@@ -246,20 +292,26 @@ func TestCreateImageConfigs(t *testing.T) {
 				},
 			},
 		},
-		shouldErr: true,
+		shouldErr:       true,
+		expectsErrClass: binding.RegistryNotFoundErrorClass,
 	}}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			rc := binding.RegistryConfig{
-				Keys:  keychain,
-				Cache: testCache,
+				Keys:              keychain,
+				Cache:             testCache,
+				MaxSBOMFileBytes:  test.maxSBOMFileBytes,
+				MaxSBOMImageBytes: test.maxSBOMImageBytes,
 			}
 
 			actual, err := rc.ResolveImageMetadata(ctx, test.input)
 			if test.shouldErr != (err != nil) {
 				t.Errorf("ResolveImageMetadata() expected error: %v, but got: %v", test.shouldErr, err)
 			}
+			if class := binding.ClassOf(err); class != test.expectsErrClass {
+				t.Errorf("ResolveImageMetadata() expected error class %q, got %q", test.expectsErrClass, class)
+			}
 			if test.shouldErr {
 				return
 			}
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const metricsNamespace = "conventions"
+
+var (
+	webhookRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "webhook_request_duration_seconds",
+		Help:      "Latency of requests to convention webhooks.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"convention"})
+	webhookRequestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "webhook_request_errors_total",
+		Help:      "Number of failed requests to convention webhooks.",
+	}, []string{"convention"})
+	imageResolutionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "image_resolution_duration_seconds",
+		Help:      "Latency of resolving image metadata from a registry.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"registry"})
+	imageResolutionFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "image_resolution_failures_total",
+		Help:      "Number of images whose metadata could not be resolved from a registry.",
+	}, []string{"registry"})
+	sbomLoadedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "sbom_loaded_bytes_total",
+		Help:      "Number of bytes of SBOM files loaded from images.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		webhookRequestDuration,
+		webhookRequestErrors,
+		imageResolutionDuration,
+		imageResolutionFailures,
+		sbomLoadedBytes,
+	)
+}
+
+func observeWebhookRequest(convention string, start time.Time, err error) {
+	webhookRequestDuration.WithLabelValues(convention).Observe(time.Since(start).Seconds())
+	if err != nil {
+		webhookRequestErrors.WithLabelValues(convention).Inc()
+	}
+}
+
+func observeImageResolution(image string, start time.Time, err error) {
+	registry := "unknown"
+	if ref, perr := name.ParseReference(image, name.WeakValidation); perr == nil {
+		registry = ref.Context().RegistryStr()
+	}
+	imageResolutionDuration.WithLabelValues(registry).Observe(time.Since(start).Seconds())
+	if err != nil {
+		imageResolutionFailures.WithLabelValues(registry).Inc()
+	}
+}
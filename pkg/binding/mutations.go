@@ -0,0 +1,287 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+)
+
+// FieldChange is a field of the pod template changed by a convention.
+type FieldChange struct {
+	// Path of the field, like `.spec.containers[workload].env`.
+	Path string
+	// Before is the JSON value of the field before the change, nil when the field was added.
+	Before json.RawMessage
+	// After is the JSON value of the field after the change, nil when the field was removed.
+	After json.RawMessage
+}
+
+// MutationError is returned when a convention changes the pod template outside of its allowed
+// mutation scopes and the convention's policy is to fail.
+type MutationError struct {
+	Convention string
+	// Paths are the fields changed outside of the allowed scopes.
+	Paths []string
+}
+
+func (e *MutationError) Error() string {
+	return fmt.Sprintf("convention %s made changes that are not allowed: %s", e.Convention, strings.Join(e.Paths, ", "))
+}
+
+// EnforceMutations checks the template returned by the convention against its allowed mutation
+// scopes. The template is returned unchanged when every change is allowed. Otherwise, depending
+// on the policy, a MutationError is returned or the disallowed changes are stripped from the
+// template. The paths of the disallowed changes are returned in either case.
+func (o *Convention) EnforceMutations(before, after *corev1.PodTemplateSpec) (*corev1.PodTemplateSpec, []string, error) {
+	if o.Mutations == nil {
+		return after, nil, nil
+	}
+	allowed := allowedTemplate(before, after, o.Mutations.AllowedScopes)
+	paths, err := mutatedPaths(allowed, after)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(paths) == 0 {
+		return after, nil, nil
+	}
+	if o.Mutations.Policy == conventionsv1alpha1.StripMutationPolicy {
+		return allowed, paths, nil
+	}
+	return nil, paths, &MutationError{Convention: o.Name, Paths: paths}
+}
+
+// allowedTemplate applies the changes within the allowed scopes to a copy of the original
+// template.
+func allowedTemplate(before, after *corev1.PodTemplateSpec, scopes []conventionsv1alpha1.MutationScope) *corev1.PodTemplateSpec {
+	allowed := before.DeepCopy()
+	for _, scope := range scopes {
+		switch scope {
+		case conventionsv1alpha1.MetadataMutationScope:
+			allowed.Labels = after.Labels
+			allowed.Annotations = after.Annotations
+		case conventionsv1alpha1.SecurityContextMutationScope:
+			allowed.Spec.SecurityContext = after.Spec.SecurityContext
+		}
+	}
+	allowedContainers(allowed.Spec.InitContainers, after.Spec.InitContainers, scopes)
+	allowedContainers(allowed.Spec.Containers, after.Spec.Containers, scopes)
+	return allowed
+}
+
+func allowedContainers(allowed, after []corev1.Container, scopes []conventionsv1alpha1.MutationScope) {
+	for i := range allowed {
+		var mutated *corev1.Container
+		for j := range after {
+			if after[j].Name == allowed[i].Name {
+				mutated = &after[j]
+				break
+			}
+		}
+		if mutated == nil {
+			// removing a container is never allowed
+			continue
+		}
+		for _, scope := range scopes {
+			switch scope {
+			case conventionsv1alpha1.EnvMutationScope:
+				allowed[i].Env = mutated.Env
+				allowed[i].EnvFrom = mutated.EnvFrom
+			case conventionsv1alpha1.ProbesMutationScope:
+				allowed[i].LivenessProbe = mutated.LivenessProbe
+				allowed[i].ReadinessProbe = mutated.ReadinessProbe
+				allowed[i].StartupProbe = mutated.StartupProbe
+			case conventionsv1alpha1.ResourcesMutationScope:
+				allowed[i].Resources = mutated.Resources
+			case conventionsv1alpha1.SecurityContextMutationScope:
+				allowed[i].SecurityContext = mutated.SecurityContext
+			}
+		}
+	}
+}
+
+// mutatedPaths returns the json paths that differ between the templates. Containers are
+// matched by name.
+func mutatedPaths(expected, actual *corev1.PodTemplateSpec) ([]string, error) {
+	return templatePaths(expected, actual, false)
+}
+
+// writtenFields returns the fields that differ between the templates, like mutatedPaths, except
+// the fields of maps and named lists that are added or removed are returned rather than the map
+// or list itself, so separate writes to the same map or list do not overlap.
+func writtenFields(before, after *corev1.PodTemplateSpec) ([]FieldChange, error) {
+	return templateChanges(before, after, true)
+}
+
+func templatePaths(expected, actual *corev1.PodTemplateSpec, expand bool) ([]string, error) {
+	changes, err := templateChanges(expected, actual, expand)
+	if err != nil {
+		return nil, err
+	}
+	return changedPaths(changes), nil
+}
+
+func templateChanges(expected, actual *corev1.PodTemplateSpec, expand bool) ([]FieldChange, error) {
+	expectedValue, err := asJSONValue(expected)
+	if err != nil {
+		return nil, err
+	}
+	actualValue, err := asJSONValue(actual)
+	if err != nil {
+		return nil, err
+	}
+	changes := diffFields("", expectedValue, actualValue, expand)
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// changedPaths returns the path of each change.
+func changedPaths(changes []FieldChange) []string {
+	paths := make([]string, len(changes))
+	for i, change := range changes {
+		paths[i] = change.Path
+	}
+	return paths
+}
+
+func asJSONValue(template *corev1.PodTemplateSpec) (interface{}, error) {
+	b, err := json.Marshal(template)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diffFields(path string, expected, actual interface{}, expand bool) []FieldChange {
+	if reflect.DeepEqual(expected, actual) {
+		return nil
+	}
+	if path == "" {
+		path = "."
+	}
+	change := []FieldChange{newFieldChange(path, expected, actual)}
+	if expand {
+		expected, actual = emptyLike(expected, actual), emptyLike(actual, expected)
+	}
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return change
+		}
+		keys := map[string]bool{}
+		for k := range e {
+			keys[k] = true
+		}
+		for k := range a {
+			keys[k] = true
+		}
+		changes := []FieldChange{}
+		for k := range keys {
+			changes = append(changes, diffFields(strings.TrimSuffix(path, ".")+"."+k, e[k], a[k], expand)...)
+		}
+		return changes
+	case []interface{}:
+		a, ok := actual.([]interface{})
+		if !ok {
+			return change
+		}
+		expectedNamed, eok := namedItems(e)
+		actualNamed, aok := namedItems(a)
+		if !eok || !aok {
+			return change
+		}
+		names := map[string]bool{}
+		for name := range expectedNamed {
+			names[name] = true
+		}
+		for name := range actualNamed {
+			names[name] = true
+		}
+		changes := []FieldChange{}
+		for name := range names {
+			changes = append(changes, diffFields(fmt.Sprintf("%s[%s]", path, name), expectedNamed[name], actualNamed[name], expand)...)
+		}
+		if len(changes) == 0 {
+			// same items in a different order
+			return change
+		}
+		return changes
+	}
+	return change
+}
+
+// newFieldChange describes the change to the field. The values were unmarshaled from JSON, so
+// marshaling them again does not fail.
+func newFieldChange(path string, before, after interface{}) FieldChange {
+	change := FieldChange{Path: path}
+	if before != nil {
+		change.Before, _ = json.Marshal(before)
+	}
+	if after != nil {
+		change.After, _ = json.Marshal(after)
+	}
+	return change
+}
+
+// emptyLike returns an empty map or list in place of a missing value when the other value is a
+// map or list.
+func emptyLike(value, other interface{}) interface{} {
+	if value != nil {
+		return value
+	}
+	switch other.(type) {
+	case map[string]interface{}:
+		return map[string]interface{}{}
+	case []interface{}:
+		return []interface{}{}
+	}
+	return value
+}
+
+// namedItems indexes a list of objects by their name, like containers or volumes.
+func namedItems(items []interface{}) (map[string]interface{}, bool) {
+	named := make(map[string]interface{}, len(items))
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := m["name"].(string)
+		if !ok {
+			return nil, false
+		}
+		if _, dup := named[name]; dup {
+			return nil, false
+		}
+		named[name] = item
+	}
+	return named, true
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+)
+
+func TestConventionEnforceMutations(t *testing.T) {
+	before := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app": "hello"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "workload",
+				Image: "ubuntu",
+			}},
+		},
+	}
+	// labels, env, resources and a new image
+	after := before.DeepCopy()
+	after.Labels["convention"] = "applied"
+	after.Spec.Containers[0].Image = "alpine"
+	after.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "KEY", Value: "VALUE"}}
+	after.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+	// a new sidecar
+	after.Spec.Containers = append(after.Spec.Containers, corev1.Container{Name: "sidecar", Image: "busybox"})
+
+	tests := []struct {
+		name          string
+		mutations     *conventionsv1alpha1.ClusterPodConventionMutations
+		mutated       *corev1.PodTemplateSpec
+		expects       *corev1.PodTemplateSpec
+		expectsPaths  []string
+		expectsFailed bool
+	}{{
+		name:    "unrestricted",
+		expects: after,
+	}, {
+		name: "strip containers",
+		mutations: &conventionsv1alpha1.ClusterPodConventionMutations{
+			AllowedScopes: []conventionsv1alpha1.MutationScope{
+				conventionsv1alpha1.MetadataMutationScope,
+				conventionsv1alpha1.EnvMutationScope,
+				conventionsv1alpha1.ResourcesMutationScope,
+			},
+			Policy: conventionsv1alpha1.StripMutationPolicy,
+		},
+		expects: func() *corev1.PodTemplateSpec {
+			t := after.DeepCopy()
+			t.Spec.Containers[0].Image = "ubuntu"
+			t.Spec.Containers = t.Spec.Containers[0:1]
+			return t
+		}(),
+		expectsPaths: []string{
+			".spec.containers[sidecar]",
+			".spec.containers[workload].image",
+		},
+	}, {
+		name: "strip",
+		mutations: &conventionsv1alpha1.ClusterPodConventionMutations{
+			AllowedScopes: []conventionsv1alpha1.MutationScope{
+				conventionsv1alpha1.EnvMutationScope,
+			},
+			Policy: conventionsv1alpha1.StripMutationPolicy,
+		},
+		expects: func() *corev1.PodTemplateSpec {
+			t := before.DeepCopy()
+			t.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "KEY", Value: "VALUE"}}
+			return t
+		}(),
+		expectsPaths: []string{
+			".metadata.labels.convention",
+			".spec.containers[sidecar]",
+			".spec.containers[workload].image",
+			".spec.containers[workload].resources.limits",
+		},
+	}, {
+		name: "fail",
+		mutations: &conventionsv1alpha1.ClusterPodConventionMutations{
+			AllowedScopes: []conventionsv1alpha1.MutationScope{
+				conventionsv1alpha1.MetadataMutationScope,
+				conventionsv1alpha1.EnvMutationScope,
+				conventionsv1alpha1.ResourcesMutationScope,
+			},
+			Policy: conventionsv1alpha1.FailMutationPolicy,
+		},
+		expectsPaths: []string{
+			".spec.containers[sidecar]",
+			".spec.containers[workload].image",
+		},
+		expectsFailed: true,
+	}, {
+		name: "removed container",
+		mutations: &conventionsv1alpha1.ClusterPodConventionMutations{
+			AllowedScopes: []conventionsv1alpha1.MutationScope{
+				conventionsv1alpha1.MetadataMutationScope,
+			},
+			Policy: conventionsv1alpha1.FailMutationPolicy,
+		},
+		mutated: func() *corev1.PodTemplateSpec {
+			t := before.DeepCopy()
+			t.Spec.Containers = nil
+			return t
+		}(),
+		expectsPaths: []string{
+			".spec.containers",
+		},
+		expectsFailed: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			convention := binding.Convention{Name: "test", Mutations: test.mutations}
+			mutated := test.mutated
+			if mutated == nil {
+				mutated = after
+			}
+			actual, paths, err := convention.EnforceMutations(before, mutated)
+			var mutationErr *binding.MutationError
+			if test.expectsFailed != errors.As(err, &mutationErr) {
+				t.Errorf("EnforceMutations() expected failure %v, got %v", test.expectsFailed, err)
+			}
+			if diff := cmp.Diff(test.expects, actual); diff != "" {
+				t.Errorf("EnforceMutations() (-expected, +actual) = %v", diff)
+			}
+			if diff := cmp.Diff(test.expectsPaths, paths); diff != "" {
+				t.Errorf("EnforceMutations() paths (-expected, +actual) = %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ServiceAccountTokens requests audience scoped tokens for the controller's service account,
+// the same tokens the kubelet projects into a pod. Tokens are cached and renewed once 80% of
+// their lifetime has elapsed. Concurrent requests for the same token share a single request to
+// the API server.
+type ServiceAccountTokens struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	now       func() time.Time
+
+	m        sync.Mutex
+	tokens   map[serviceAccountTokenKey]*serviceAccountToken
+	requests singleflight.Group
+}
+
+type serviceAccountTokenKey struct {
+	audience          string
+	expirationSeconds int64
+}
+
+func (k serviceAccountTokenKey) String() string {
+	return fmt.Sprintf("%s/%d", k.audience, k.expirationSeconds)
+}
+
+type serviceAccountToken struct {
+	token     string
+	renewTime time.Time
+}
+
+// NewServiceAccountTokens creates tokens for the service account.
+func NewServiceAccountTokens(client kubernetes.Interface, namespace, name string) *ServiceAccountTokens {
+	return &ServiceAccountTokens{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		now:       time.Now,
+		tokens:    map[serviceAccountTokenKey]*serviceAccountToken{},
+	}
+}
+
+// Token returns a token for the audience, requesting a new token when there is no cached token
+// or it is due for renewal.
+func (t *ServiceAccountTokens) Token(ctx context.Context, audience string, expirationSeconds int64) (string, error) {
+	if t == nil {
+		return "", fmt.Errorf("service account tokens are not configured")
+	}
+	key := serviceAccountTokenKey{audience: audience, expirationSeconds: expirationSeconds}
+	if token, ok := t.cached(key); ok {
+		return token, nil
+	}
+	// the lock is not held while requesting the token, a slow API server must not block
+	// conventions using other tokens
+	token, err, _ := t.requests.Do(key.String(), func() (interface{}, error) {
+		if token, ok := t.cached(key); ok {
+			// renewed by a request that completed since the cache was checked
+			return token, nil
+		}
+		issued := t.now()
+		tr, err := t.client.CoreV1().ServiceAccounts(t.namespace).CreateToken(ctx, t.name, &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				Audiences:         []string{audience},
+				ExpirationSeconds: &expirationSeconds,
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return "", classifyTokenError(fmt.Errorf("failed to request a token for audience %q: %w", audience, err))
+		}
+		lifetime := tr.Status.ExpirationTimestamp.Sub(issued)
+		t.m.Lock()
+		defer t.m.Unlock()
+		t.tokens[key] = &serviceAccountToken{
+			token:     tr.Status.Token,
+			renewTime: issued.Add(lifetime * 8 / 10),
+		}
+		return tr.Status.Token, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return token.(string), nil
+}
+
+// cached returns the token for the key unless it is due for renewal.
+func (t *ServiceAccountTokens) cached(key serviceAccountTokenKey) (string, bool) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	if cached, ok := t.tokens[key]; ok && t.now().Before(cached.renewTime) {
+		return cached.token, true
+	}
+	return "", false
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	webhooktesting "k8s.io/apiserver/pkg/admission/plugin/webhook/testing"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	clienttesting "k8s.io/client-go/testing"
+
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+)
+
+func newTokenClient(requests *[]authenticationv1.TokenRequestSpec) *fake.Clientset {
+	client := fake.NewClientset()
+	client.PrependReactor("create", "serviceaccounts", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		tr := action.(clienttesting.CreateAction).GetObject().(*authenticationv1.TokenRequest).DeepCopy()
+		*requests = append(*requests, tr.Spec)
+		tr.Status = authenticationv1.TokenRequestStatus{
+			Token:               fmt.Sprintf("token-%d", len(*requests)),
+			ExpirationTimestamp: metav1.NewTime(time.Now().Add(time.Duration(*tr.Spec.ExpirationSeconds) * time.Second)),
+		}
+		return true, tr, nil
+	})
+	return client
+}
+
+func TestServiceAccountTokens(t *testing.T) {
+	requests := []authenticationv1.TokenRequestSpec{}
+	tokens := binding.NewServiceAccountTokens(newTokenClient(&requests), "conventions-system", "controller-manager")
+	ctx := context.Background()
+
+	for _, expected := range []string{"token-1", "token-1"} {
+		token, err := tokens.Token(ctx, "my-convention", 3600)
+		if err != nil {
+			t.Fatalf("Token() unexpected error: %v", err)
+		}
+		if token != expected {
+			t.Errorf("Token() expected %q, got %q", expected, token)
+		}
+	}
+	// a distinct audience is a distinct token
+	if token, _ := tokens.Token(ctx, "other-convention", 3600); token != "token-2" {
+		t.Errorf("Token() expected %q, got %q", "token-2", token)
+	}
+	// an expired token is renewed
+	if token, _ := tokens.Token(ctx, "expiring-convention", 0); token != "token-3" {
+		t.Errorf("Token() expected %q, got %q", "token-3", token)
+	}
+	if token, _ := tokens.Token(ctx, "expiring-convention", 0); token != "token-4" {
+		t.Errorf("Token() expected %q, got %q", "token-4", token)
+	}
+
+	expirationSeconds := int64(3600)
+	if diff := cmp.Diff(authenticationv1.TokenRequestSpec{
+		Audiences:         []string{"my-convention"},
+		ExpirationSeconds: &expirationSeconds,
+	}, requests[0]); diff != "" {
+		t.Errorf("TokenRequest (-expected, +actual) = %v", diff)
+	}
+
+	var nilTokens *binding.ServiceAccountTokens
+	if _, err := nilTokens.Token(ctx, "my-convention", 3600); err == nil {
+		t.Errorf("Token() expected error when not configured")
+	}
+}
+
+// gatedTokenClient blocks token requests for an audience until the gate is released. The fake
+// clientset serializes its reactors, so the gate sits in front of it.
+type gatedTokenClient struct {
+	kubernetes.Interface
+	audience string
+	entered  chan struct{}
+	gate     chan struct{}
+}
+
+func (c *gatedTokenClient) CoreV1() corev1client.CoreV1Interface {
+	return &gatedCoreV1{CoreV1Interface: c.Interface.CoreV1(), client: c}
+}
+
+type gatedCoreV1 struct {
+	corev1client.CoreV1Interface
+	client *gatedTokenClient
+}
+
+func (c *gatedCoreV1) ServiceAccounts(namespace string) corev1client.ServiceAccountInterface {
+	return &gatedServiceAccounts{ServiceAccountInterface: c.CoreV1Interface.ServiceAccounts(namespace), client: c.client}
+}
+
+type gatedServiceAccounts struct {
+	corev1client.ServiceAccountInterface
+	client *gatedTokenClient
+}
+
+func (s *gatedServiceAccounts) CreateToken(ctx context.Context, name string, tr *authenticationv1.TokenRequest, opts metav1.CreateOptions) (*authenticationv1.TokenRequest, error) {
+	if tr.Spec.Audiences[0] == s.client.audience {
+		close(s.client.entered)
+		<-s.client.gate
+	}
+	return s.ServiceAccountInterface.CreateToken(ctx, name, tr, opts)
+}
+
+func TestServiceAccountTokensConcurrentAudiences(t *testing.T) {
+	requests := []authenticationv1.TokenRequestSpec{}
+	client := &gatedTokenClient{
+		Interface: newTokenClient(&requests),
+		audience:  "slow-convention",
+		entered:   make(chan struct{}),
+		gate:      make(chan struct{}),
+	}
+	tokens := binding.NewServiceAccountTokens(client, "conventions-system", "controller-manager")
+	ctx := context.Background()
+
+	slow := make(chan error, 1)
+	go func() {
+		_, err := tokens.Token(ctx, "slow-convention", 3600)
+		slow <- err
+	}()
+	<-client.entered
+
+	// a pending request for one audience does not block requests for other audiences
+	fast := make(chan error, 1)
+	go func() {
+		_, err := tokens.Token(ctx, "my-convention", 3600)
+		fast <- err
+	}()
+	select {
+	case err := <-fast:
+		if err != nil {
+			t.Errorf("Token() unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("Token() blocked by a pending request for another audience")
+	}
+
+	close(client.gate)
+	if err := <-slow; err != nil {
+		t.Errorf("Token() unexpected error: %v", err)
+	}
+}
+
+func TestServiceAccountTokensErrorClass(t *testing.T) {
+	resource := schema.GroupResource{Resource: "serviceaccounts"}
+	tests := []struct {
+		name          string
+		err           error
+		expectedClass binding.ErrorClass
+	}{{
+		name:          "forbidden",
+		err:           apierrors.NewForbidden(resource, "controller-manager", fmt.Errorf("cannot create resource")),
+		expectedClass: binding.ServiceAccountTokenErrorClass,
+	}, {
+		name:          "not found",
+		err:           apierrors.NewNotFound(resource, "controller-manager"),
+		expectedClass: binding.ServiceAccountTokenErrorClass,
+	}, {
+		name:          "unavailable",
+		err:           apierrors.NewServiceUnavailable("etcd is unavailable"),
+		expectedClass: binding.TransientErrorClass,
+	}, {
+		name:          "throttled",
+		err:           apierrors.NewTooManyRequests("slow down", 1),
+		expectedClass: binding.TransientErrorClass,
+	}, {
+		name:          "timeout",
+		err:           context.DeadlineExceeded,
+		expectedClass: binding.TransientErrorClass,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := fake.NewClientset()
+			client.PrependReactor("create", "serviceaccounts", func(action clienttesting.Action) (bool, runtime.Object, error) {
+				return true, nil, test.err
+			})
+			tokens := binding.NewServiceAccountTokens(client, "conventions-system", "controller-manager")
+			_, err := tokens.Token(context.Background(), "my-convention", 3600)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Token() expected error %v, got %v", test.err, err)
+			}
+			if class := binding.ClassOf(err); class != test.expectedClass {
+				t.Errorf("Token() expected class %s, got %s", test.expectedClass, class)
+			}
+		})
+	}
+}
+
+func TestConventionServiceAccountToken(t *testing.T) {
+	testServer := NewTestServer(t)
+	testServer.Start()
+	defer testServer.Close()
+
+	requests := []authenticationv1.TokenRequestSpec{}
+	convention := binding.Convention{
+		Name: "test-os",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			URL: strPtr(fmt.Sprintf("%s/%s", testServer.URL, "authorization")),
+		},
+		ServiceAccountToken: &conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken{
+			Audience: "my-convention",
+		},
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver:     webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceAccountTokens: binding.NewServiceAccountTokens(newTokenClient(&requests), "conventions-system", "controller-manager"),
+	}
+	actual, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc)
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"Bearer token-1"}, actual.Status.AppliedConventions); diff != "" {
+		t.Errorf("Apply() authorization (-expected, +actual) = %v", diff)
+	}
+}
+
+func TestConventionServiceAccountTokenCircuitOpen(t *testing.T) {
+	testServer := NewTestServer(t)
+	testServer.Start()
+	defer testServer.Close()
+
+	requests := []authenticationv1.TokenRequestSpec{}
+	// tokens that expire immediately are requested for every call
+	expirationSeconds := int64(0)
+	convention := binding.Convention{
+		Name: "test-os",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			URL: strPtr(fmt.Sprintf("%s/%s", testServer.URL, "wrongstatuscode")),
+		},
+		ServiceAccountToken: &conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken{
+			Audience:          "my-convention",
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	wc := binding.WebhookConfig{
+		AuthInfoResolver:     webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceAccountTokens: binding.NewServiceAccountTokens(newTokenClient(&requests), "conventions-system", "controller-manager"),
+		CircuitBreakers:      binding.NewCircuitBreakers(binding.CircuitBreakerOptions{FailureThreshold: 1, OpenDuration: time.Hour}),
+	}
+	if _, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc); err == nil {
+		t.Fatalf("Apply() expected the request to fail")
+	}
+	var circuitErr *binding.CircuitOpenError
+	if _, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc); !errors.As(err, &circuitErr) {
+		t.Fatalf("Apply() expected the circuit to be open, got %v", err)
+	}
+	if len(requests) != 1 {
+		t.Errorf("expected a token to be requested only while the circuit is closed, got %d requests", len(requests))
+	}
+}
+
+func TestConventionServiceAccountTokenCircuitHalfOpen(t *testing.T) {
+	testServer := NewTestServer(t)
+	testServer.Start()
+	defer testServer.Close()
+
+	requests := []authenticationv1.TokenRequestSpec{}
+	client := newTokenClient(&requests)
+	tokenErr := fmt.Errorf("the server is currently unable to handle the request")
+	failTokens := true
+	client.PrependReactor("create", "serviceaccounts", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return failTokens, nil, tokenErr
+	})
+	convention := binding.Convention{
+		Name: "test-os",
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			URL: strPtr(fmt.Sprintf("%s/%s", testServer.URL, "authorization")),
+		},
+		ServiceAccountToken: &conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken{
+			Audience: "my-convention",
+		},
+	}
+	circuitBreakers := binding.NewCircuitBreakers(binding.CircuitBreakerOptions{FailureThreshold: 1, OpenDuration: 50 * time.Millisecond})
+	wc := binding.WebhookConfig{
+		AuthInfoResolver:     webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceAccountTokens: binding.NewServiceAccountTokens(client, "conventions-system", "controller-manager"),
+		CircuitBreakers:      circuitBreakers,
+	}
+	circuitBreakers.Record(convention.Name, fmt.Errorf("connection refused"))
+
+	// the trial request fails for its token
+	time.Sleep(60 * time.Millisecond)
+	if _, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc); !errors.Is(err, tokenErr) {
+		t.Fatalf("Apply() expected the token error, got %v", err)
+	}
+	if state := circuitBreakers.Status(convention.Name).State; state != conventionsv1alpha1.OpenCircuitBreakerState {
+		t.Errorf("expected the failed trial to open the circuit, got %s", state)
+	}
+
+	// the next trial is sent once the open duration elapses
+	failTokens = false
+	time.Sleep(60 * time.Millisecond)
+	if _, err := convention.Apply(context.Background(), &webhookv1alpha1.PodConventionContext{}, wc); err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	if state := circuitBreakers.Status(convention.Name).State; state != conventionsv1alpha1.ClosedCircuitBreakerState {
+		t.Errorf("expected the successful trial to close the circuit, got %s", state)
+	}
+}
@@ -26,6 +26,10 @@ import (
 type WebhookConfig struct {
 	AuthInfoResolver webhookutil.AuthenticationInfoResolver
 	ServiceResolver  webhookutil.ServiceResolver
+	// CircuitBreakers fast-fail requests to conventions that are failing, disabled when nil.
+	CircuitBreakers *CircuitBreakers
+	// ServiceAccountTokens issues the tokens sent to conventions that request one.
+	ServiceAccountTokens *ServiceAccountTokens
 }
 
 func NewClientManager(wc WebhookConfig, grp schema.GroupVersion, addToSchemaFunc func(s *runtime.Scheme) error) (cm webhook.ClientManager, err error) {
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+)
+
+type backoffPolicy struct {
+	initial time.Duration
+	max     time.Duration
+}
+
+// backoffPolicies is the delay before a PodIntent is reconciled again after the first failure
+// of each class, doubling for each consecutive failure up to the max. Failures that need a
+// change to a convention or to credentials back off further than those likely to resolve
+// themselves.
+var backoffPolicies = map[binding.ErrorClass]backoffPolicy{
+	binding.TransientErrorClass:           {initial: 5 * time.Second, max: 5 * time.Minute},
+	binding.WebhookServerErrorClass:       {initial: 10 * time.Second, max: 10 * time.Minute},
+	binding.WebhookInvalidResponseClass:   {initial: time.Minute, max: time.Hour},
+	binding.RegistryAuthErrorClass:        {initial: 30 * time.Second, max: 30 * time.Minute},
+	binding.RegistryNotFoundErrorClass:    {initial: 30 * time.Second, max: 30 * time.Minute},
+	binding.ServiceAccountTokenErrorClass: {initial: 30 * time.Second, max: 30 * time.Minute},
+	binding.UnknownErrorClass:             {initial: 10 * time.Second, max: 10 * time.Minute},
+}
+
+// requeueBackoff tracks consecutive failures of each class per PodIntent.
+type requeueBackoff map[binding.ErrorClass]*flowcontrol.Backoff
+
+func newRequeueBackoff() requeueBackoff {
+	b := requeueBackoff{}
+	for class, policy := range backoffPolicies {
+		b[class] = flowcontrol.NewBackOff(policy.initial, policy.max)
+	}
+	return b
+}
+
+// next records a failure for the PodIntent and returns how long to wait before retrying.
+func (b requeueBackoff) next(class binding.ErrorClass, parent *conventionsv1alpha1.PodIntent) time.Duration {
+	backoff, ok := b[class]
+	if !ok {
+		backoff = b[binding.UnknownErrorClass]
+	}
+	id := backoffID(parent)
+	backoff.Next(id, backoff.Clock.Now())
+	// drop PodIntents that have not failed recently, including deleted ones
+	backoff.GC()
+	return backoff.Get(id)
+}
+
+// reset forgets previous failures for the PodIntent.
+func (b requeueBackoff) reset(parent *conventionsv1alpha1.PodIntent) {
+	id := backoffID(parent)
+	for _, backoff := range b {
+		backoff.Reset(id)
+	}
+}
+
+func backoffID(parent *conventionsv1alpha1.PodIntent) string {
+	return types.NamespacedName{Namespace: parent.Namespace, Name: parent.Name}.String()
+}
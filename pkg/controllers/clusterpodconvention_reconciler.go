@@ -0,0 +1,79 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+)
+
+// +kubebuilder:rbac:groups=conventions.carto.run,resources=clusterpodconventions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=conventions.carto.run,resources=clusterpodconventions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=core;events.k8s.io,resources=events,verbs=get;list;watch;create;update;patch;delete
+
+func ClusterPodConventionReconciler(c reconcilers.Config, breakers *binding.CircuitBreakers) *reconcilers.ResourceReconciler[*conventionsv1alpha1.ClusterPodConvention] {
+	return &reconcilers.ResourceReconciler[*conventionsv1alpha1.ClusterPodConvention]{
+		Name:       "ClusterPodConvention",
+		Reconciler: CircuitBreakerStatus(breakers),
+
+		Config: c,
+	}
+}
+
+func CircuitBreakerStatus(breakers *binding.CircuitBreakers) reconcilers.SubReconciler[*conventionsv1alpha1.ClusterPodConvention] {
+	return &reconcilers.SyncReconciler[*conventionsv1alpha1.ClusterPodConvention]{
+		Name: "CircuitBreakerStatus",
+		Sync: func(ctx context.Context, parent *conventionsv1alpha1.ClusterPodConvention) error {
+			parent.Status.CircuitBreaker = breakers.Status(parent.Name)
+			return nil
+		},
+
+		Setup: func(ctx context.Context, mgr reconcilers.Manager, bldr *reconcilers.Builder) error {
+			if breakers == nil {
+				return nil
+			}
+			// reconcile the convention each time its circuit changes state
+			events := make(chan event.GenericEvent)
+			go func() {
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case name := <-breakers.Changes():
+						convention := &conventionsv1alpha1.ClusterPodConvention{ObjectMeta: metav1.ObjectMeta{Name: name}}
+						select {
+						case events <- event.GenericEvent{Object: convention}:
+						case <-ctx.Done():
+							return
+						}
+					}
+				}
+			}()
+			bldr.WatchesRawSource(source.Channel(events, &handler.TypedEnqueueRequestForObject[client.Object]{}))
+			return nil
+		},
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	diemetav1 "reconciler.io/dies/apis/meta/v1"
+	"reconciler.io/runtime/reconcilers"
+	rtesting "reconciler.io/runtime/testing"
+
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+	controllers "github.com/vmware-tanzu/cartographer-conventions/pkg/controllers"
+	dieconventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/dies/conventions/v1alpha1"
+)
+
+func TestCircuitBreakerStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = conventionsv1alpha1.AddToScheme(scheme)
+
+	parent := dieconventionsv1alpha1.ClusterPodConventionBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("test-convention")
+		})
+
+	rts := rtesting.SubReconcilerTests[*conventionsv1alpha1.ClusterPodConvention]{
+		"disabled": {
+			Metadata: map[string]interface{}{
+				"CircuitBreakers": (*binding.CircuitBreakers)(nil),
+			},
+			Resource:       parent.DieReleasePtr(),
+			ExpectResource: parent.DieReleasePtr(),
+		},
+		"closed": {
+			Metadata: map[string]interface{}{
+				"CircuitBreakers": binding.NewCircuitBreakers(binding.CircuitBreakerOptions{FailureThreshold: 5}),
+			},
+			Resource: parent.DieReleasePtr(),
+			ExpectResource: parent.
+				StatusDie(func(d *dieconventionsv1alpha1.ClusterPodConventionStatusDie) {
+					d.CircuitBreakerDie(func(d *dieconventionsv1alpha1.ClusterPodConventionCircuitBreakerStatusDie) {
+						d.State(conventionsv1alpha1.ClosedCircuitBreakerState)
+					})
+				}).
+				DieReleasePtr(),
+		},
+	}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*conventionsv1alpha1.ClusterPodConvention], c reconcilers.Config) reconcilers.SubReconciler[*conventionsv1alpha1.ClusterPodConvention] {
+		return controllers.CircuitBreakerStatus(rtc.Metadata["CircuitBreakers"].(*binding.CircuitBreakers))
+	})
+}
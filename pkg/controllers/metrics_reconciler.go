@@ -25,6 +25,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
@@ -36,6 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -49,6 +51,16 @@ const (
 	clusterPodConventionNamesMetricPrefix = "clusterpodconventions_names"
 )
 
+var podIntentsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "conventions",
+	Name:      "podintents",
+	Help:      "Number of PodIntents by the status of their Ready condition.",
+}, []string{"ready"})
+
+func init() {
+	metrics.Registry.MustRegister(podIntentsGauge)
+}
+
 // MetricsReconciler reconciles workload intent, cluster convention objects
 type MetricsReconciler struct {
 	client.Client
@@ -88,6 +100,7 @@ func (r *MetricsReconciler) reconcile(ctx context.Context, configMap *corev1.Con
 		log.Error(err, "Failed to get PodIntents", "configmap", configMap)
 		return ctrl.Result{}, err
 	}
+	recordPodIntentMetrics(intents.Items)
 
 	var clusterSources conventionsv1alpha1.ClusterPodConventionList
 	if err := r.List(ctx, &clusterSources); err != nil {
@@ -121,16 +134,7 @@ func buildConfigMapData(podIntents []conventionsv1alpha1.PodIntent, clusterPodCo
 			groupKind := schema.FromAPIVersionAndKind(ownerRef.APIVersion, ownerRef.Kind).GroupKind().String()
 			groupKindMap[groupKind] = groupKindMap[groupKind] + 1
 		}
-		readyCond := wd.Status.GetCondition(conventionsv1alpha1.PodIntentConditionReady)
-		status := "unknown"
-		if readyCond != nil {
-			if readyCond.Status == metav1.ConditionTrue {
-				status = "true"
-			}
-			if readyCond.Status == metav1.ConditionFalse {
-				status = "false"
-			}
-		}
+		status := readyStatus(&wd)
 		statusMap[status] = statusMap[status] + 1
 	}
 	metricsConfigMap := make(map[string]string)
@@ -151,6 +155,31 @@ func buildConfigMapData(podIntents []conventionsv1alpha1.PodIntent, clusterPodCo
 	return metricsConfigMap
 }
 
+func readyStatus(podIntent *conventionsv1alpha1.PodIntent) string {
+	readyCond := podIntent.Status.GetCondition(conventionsv1alpha1.PodIntentConditionReady)
+	status := "unknown"
+	if readyCond != nil {
+		if readyCond.Status == metav1.ConditionTrue {
+			status = "true"
+		}
+		if readyCond.Status == metav1.ConditionFalse {
+			status = "false"
+		}
+	}
+	return status
+}
+
+// recordPodIntentMetrics updates the prometheus gauge of PodIntents per ready status.
+func recordPodIntentMetrics(podIntents []conventionsv1alpha1.PodIntent) {
+	statusMap := map[string]int{"true": 0, "false": 0, "unknown": 0}
+	for i := range podIntents {
+		statusMap[readyStatus(&podIntents[i])]++
+	}
+	for status, count := range statusMap {
+		podIntentsGauge.WithLabelValues(status).Set(float64(count))
+	}
+}
+
 func (r *MetricsReconciler) reconcileConfigMap(ctx context.Context, existingConfigMap *corev1.ConfigMap, configMapContents map[string]string) (*corev1.ConfigMap, error) {
 	log := logr.FromContextOrDiscard(ctx)
 
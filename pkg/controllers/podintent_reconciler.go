@@ -19,17 +19,33 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
+	"path"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/go-containerregistry/pkg/authn/k8schain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/lru"
 	"reconciler.io/runtime/apis"
 	"reconciler.io/runtime/reconcilers"
 	"reconciler.io/runtime/tracker"
@@ -37,10 +53,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
 	certmanagerv1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/thirdparty/cert-manager/v1"
 	"github.com/vmware-tanzu/cartographer-conventions/pkg/binding"
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
 )
 
 const (
@@ -88,13 +107,19 @@ func PodIntentReconciler(c reconcilers.Config, wc binding.WebhookConfig, rc bind
 }
 
 // +kubebuilder:rbac:groups=conventions.carto.run,resources=clusterpodconventions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=conventions.carto.run,resources=conventionprofiles,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=limitranges,verbs=get;list;watch
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificaterequests,verbs=get;list;watch
 
 func ResolveConventions() reconcilers.SubReconciler[*conventionsv1alpha1.PodIntent] {
 	return &reconcilers.SyncReconciler[*conventionsv1alpha1.PodIntent]{
 		Name: "ResolveConventions",
 		Sync: func(ctx context.Context, parent *conventionsv1alpha1.PodIntent) error {
+			ctx, span := startSpan(ctx, "ResolveConventions", parent)
+			defer span.End()
 			log := logr.FromContextOrDiscard(ctx)
 			c := reconcilers.RetrieveConfigOrDie(ctx)
 			sources := &conventionsv1alpha1.ClusterPodConventionList{}
@@ -103,13 +128,39 @@ func ResolveConventions() reconcilers.SubReconciler[*conventionsv1alpha1.PodInte
 			}
 			var conventions binding.Conventions
 			conditionManager := parent.GetConditionSet().ManageWithContext(ctx, &parent.Status)
+			profile, err := resolveProfile(ctx, c, parent)
+			if err != nil {
+				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ProfileResolutionFailed", "failed to resolve profile: %v", err.Error())
+				c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ProfileResolutionFailed", "Failed to resolve convention profile: %v", err)
+				log.Error(err, "failed to resolve profile")
+				return nil
+			}
 			for i := range sources.Items {
 				source := sources.Items[i].DeepCopy()
 				_ = source.Spec.Default()
+				inProfile := profile != nil && profile.Spec.Includes(source.Name)
+				if profile != nil && !inProfile && !source.Spec.Mandatory {
+					// only the conventions in the profile, and mandatory conventions, are applied
+					continue
+				}
 				convention := binding.Convention{
-					Name:      source.Name,
-					Selectors: source.Spec.Selectors,
-					Priority:  source.Spec.Priority,
+					Name:            source.Name,
+					Selectors:       source.Spec.Selectors,
+					Priority:        source.Spec.Priority,
+					Order:           source.Spec.Order,
+					RunAfter:        source.Spec.RunAfter,
+					RunBefore:       source.Spec.RunBefore,
+					ExclusiveFields: source.Spec.ExclusiveFields,
+					Mandatory:       source.Spec.Mandatory,
+					Mutations:       source.Spec.Mutations,
+					Validating:      source.Spec.Mode == conventionsv1alpha1.ValidateConventionMode,
+				}
+				var sharedParams map[string]string
+				if inProfile {
+					// the profile replaces the selectors of its conventions, applying them in order
+					convention.Selectors = nil
+					convention.RunAfter = append(slices.Clone(convention.RunAfter), profile.Spec.Preceding(source.Name)...)
+					sharedParams = profile.Spec.Params
 				}
 				if source.Spec.Webhook != nil {
 					clientConfig := source.Spec.Webhook.ClientConfig.DeepCopy()
@@ -117,6 +168,7 @@ func ResolveConventions() reconcilers.SubReconciler[*conventionsv1alpha1.PodInte
 						caBundle, err := getCABundle(ctx, c, source.Spec.Webhook.Certificate, parent, source)
 						if err != nil {
 							conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "CABundleResolutionFailed", "failed to authenticate: %v", err.Error())
+							c.Recorder.Eventf(parent, corev1.EventTypeWarning, "CABundleResolutionFailed", "Failed to resolve CA bundle for convention %s: %v", source.Name, err)
 							log.Error(err, "failed to get CABundle", "ClusterPodConvention", source.Name)
 							return nil
 						}
@@ -124,7 +176,25 @@ func ResolveConventions() reconcilers.SubReconciler[*conventionsv1alpha1.PodInte
 						clientConfig.CABundle = caBundle
 					}
 					convention.ClientConfig = *clientConfig
+					convention.BOMs = source.Spec.Webhook.BOMs
+					convention.ServiceAccountToken = source.Spec.Webhook.ServiceAccountToken
+				}
+				params, err := resolveParams(ctx, c, source.Spec.Params, sharedParams, parent)
+				if err != nil {
+					conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ParamsResolutionFailed", "failed to resolve params: %v", err.Error())
+					c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ParamsResolutionFailed", "Failed to resolve params for convention %s: %v", source.Name, err)
+					log.Error(err, "failed to resolve params", "ClusterPodConvention", source.Name)
+					return nil
 				}
+				convention.Params = params
+				resources, err := resolveResources(ctx, c, source.Spec.Resources, parent)
+				if err != nil {
+					conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ResourcesResolutionFailed", "failed to resolve resources: %v", err.Error())
+					c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ResourcesResolutionFailed", "Failed to resolve resources for convention %s: %v", source.Name, err)
+					log.Error(err, "failed to resolve resources", "ClusterPodConvention", source.Name)
+					return nil
+				}
+				convention.Resources = resources
 				conventions = append(conventions, convention)
 			}
 			StashConventions(ctx, conventions)
@@ -132,9 +202,13 @@ func ResolveConventions() reconcilers.SubReconciler[*conventionsv1alpha1.PodInte
 		},
 
 		Setup: func(ctx context.Context, mgr ctrl.Manager, bldr *builder.Builder) error {
-			// register an informer to watch ClusterPodConventions
-			bldr.Watches(&conventionsv1alpha1.ClusterPodConvention{}, &handler.Funcs{})
+			// status updates, like the circuit breaker, do not change how conventions are applied
+			bldr.Watches(&conventionsv1alpha1.ClusterPodConvention{}, handler.EnqueueRequestsFromMapFunc(enqueueAllPodIntents(mgr.GetClient())), builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+			bldr.Watches(&conventionsv1alpha1.ConventionProfile{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.Namespace{}, handler.EnqueueRequestsFromMapFunc(enqueuePodIntentsInNamespace(mgr.GetClient())))
 			bldr.Watches(&certmanagerv1.CertificateRequest{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.ConfigMap{}, reconcilers.EnqueueTracked(ctx))
+			bldr.Watches(&corev1.LimitRange{}, reconcilers.EnqueueTracked(ctx))
 
 			return nil
 		},
@@ -145,9 +219,12 @@ func ResolveConventions() reconcilers.SubReconciler[*conventionsv1alpha1.PodInte
 // +kubebuilder:rbac:groups=core,resources=serviceaccounts,verbs=get;list;watch
 
 func BuildRegistryConfig(rc binding.RegistryConfig) reconcilers.SubReconciler[*conventionsv1alpha1.PodIntent] {
+	backoff := newRequeueBackoff()
 	return &reconcilers.SyncReconciler[*conventionsv1alpha1.PodIntent]{
 		Name: "BuildRegistryConfig",
 		SyncWithResult: func(ctx context.Context, parent *conventionsv1alpha1.PodIntent) (ctrl.Result, error) {
+			ctx, span := startSpan(ctx, "BuildRegistryConfig", parent)
+			defer span.End()
 			log := logr.FromContextOrDiscard(ctx)
 			c := reconcilers.RetrieveConfigOrDie(ctx)
 			if rc.Client == nil {
@@ -176,9 +253,11 @@ func BuildRegistryConfig(rc binding.RegistryConfig) reconcilers.SubReconciler[*c
 				ImagePullSecrets:   imagePullSecrets,
 			})
 			if err != nil {
-				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ImageResolutionFailed", "failed to authenticate: %v", err.Error())
+				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, string(binding.RegistryAuthErrorClass), "failed to authenticate: %v", err.Error())
+				c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ImageResolutionFailed", "Failed to authenticate with image registries: %v", err)
 				log.Error(err, "fetching authentication for Images failed")
-				return ctrl.Result{}, nil
+				// the service account or its secrets may not exist yet
+				return ctrl.Result{RequeueAfter: backoff.next(binding.RegistryAuthErrorClass, parent)}, nil
 			}
 
 			serviceAccountNamespacedName := types.NamespacedName{Namespace: parent.Namespace, Name: serviceAccountName}
@@ -186,8 +265,9 @@ func BuildRegistryConfig(rc binding.RegistryConfig) reconcilers.SubReconciler[*c
 			if err = c.TrackAndGet(ctx, serviceAccountNamespacedName, sa); err != nil {
 				log.Error(err, "fetching serviceAccount failed")
 				// should not happen mostly.
-				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ImageResolutionFailed", "failed to authenticate: %v", err.Error())
-				return ctrl.Result{}, nil
+				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, string(binding.RegistryAuthErrorClass), "failed to authenticate: %v", err.Error())
+				c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ImageResolutionFailed", "Failed to authenticate with image registries: %v", err)
+				return ctrl.Result{RequeueAfter: backoff.next(binding.RegistryAuthErrorClass, parent)}, nil
 			}
 
 			for _, secretReference := range sa.ImagePullSecrets {
@@ -201,11 +281,15 @@ func BuildRegistryConfig(rc binding.RegistryConfig) reconcilers.SubReconciler[*c
 				c.Tracker.TrackReference(ref, parent)
 			}
 
+			backoff.reset(parent)
 			StashRegistryConfig(ctx, binding.RegistryConfig{
 				Keys:       kc,
 				Cache:      rc.Cache,
 				Client:     rc.Client,
 				CACertPath: rc.CACertPath,
+
+				MaxSBOMFileBytes:  rc.MaxSBOMFileBytes,
+				MaxSBOMImageBytes: rc.MaxSBOMImageBytes,
 			})
 			return ctrl.Result{}, nil
 		},
@@ -267,14 +351,215 @@ func getCABundle(ctx context.Context, c reconcilers.Config, certRef *conventions
 	return caData.Bytes(), nil
 }
 
+// resolveProfile returns the ConventionProfile named by the PodIntent, or else by the label of
+// the PodIntent's namespace, or nil when no profile is selected. The profile is tracked so the
+// PodIntent is reconciled when it changes, namespaces are watched separately.
+func resolveProfile(ctx context.Context, c reconcilers.Config, parent *conventionsv1alpha1.PodIntent) (*conventionsv1alpha1.ConventionProfile, error) {
+	var name string
+	if parent.Spec.Conventions != nil {
+		name = parent.Spec.Conventions.Profile
+	}
+	if name == "" {
+		namespace := &corev1.Namespace{}
+		if err := c.Get(ctx, types.NamespacedName{Name: parent.Namespace}, namespace); err != nil {
+			if !apierrs.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get Namespace %q: %w", parent.Namespace, err)
+			}
+		}
+		name = namespace.Labels[conventionsv1alpha1.ProfileLabelKey]
+	}
+	if name == "" {
+		return nil, nil
+	}
+	profile := &conventionsv1alpha1.ConventionProfile{}
+	if err := c.TrackAndGet(ctx, types.NamespacedName{Name: name}, profile); err != nil {
+		return nil, fmt.Errorf("failed to get ConventionProfile %q: %w", name, err)
+	}
+	return profile, nil
+}
+
+// enqueueAllPodIntents reconciles every PodIntent when a ClusterPodConvention changes, as any
+// PodIntent may be selected by the convention.
+func enqueueAllPodIntents(c client.Reader) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		podIntents := &conventionsv1alpha1.PodIntentList{}
+		if err := c.List(ctx, podIntents); err != nil {
+			logr.FromContextOrDiscard(ctx).Error(err, "failed to list PodIntents", "ClusterPodConvention", obj.GetName())
+			return nil
+		}
+		return podIntentRequests(podIntents)
+	}
+}
+
+// enqueuePodIntentsInNamespace reconciles the PodIntents in a namespace when the namespace
+// changes, as its labels select a ConventionProfile.
+func enqueuePodIntentsInNamespace(c client.Reader) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		podIntents := &conventionsv1alpha1.PodIntentList{}
+		if err := c.List(ctx, podIntents, client.InNamespace(obj.GetName())); err != nil {
+			logr.FromContextOrDiscard(ctx).Error(err, "failed to list PodIntents", "Namespace", obj.GetName())
+			return nil
+		}
+		return podIntentRequests(podIntents)
+	}
+}
+
+func podIntentRequests(podIntents *conventionsv1alpha1.PodIntentList) []reconcile.Request {
+	requests := make([]reconcile.Request, len(podIntents.Items))
+	for i := range podIntents.Items {
+		requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{
+			Namespace: podIntents.Items[i].Namespace,
+			Name:      podIntents.Items[i].Name,
+		}}
+	}
+	return requests
+}
+
+// resolveParams merges the params read from ConfigMaps and Secrets with the inline values and
+// the params shared by the convention's profile, then applies the overrides from the PodIntent's
+// namespace. Referenced objects are tracked so the PodIntent is reconciled when they change.
+func resolveParams(ctx context.Context, c reconcilers.Config, params *conventionsv1alpha1.ClusterPodConventionParams, shared map[string]string, parent *conventionsv1alpha1.PodIntent) (map[string]string, error) {
+	if params == nil {
+		if len(shared) == 0 {
+			return nil, nil
+		}
+		params = &conventionsv1alpha1.ClusterPodConventionParams{}
+	}
+	resolved := map[string]string{}
+	for _, from := range params.From {
+		switch {
+		case from.ConfigMapRef != nil:
+			configMap := &corev1.ConfigMap{}
+			key := types.NamespacedName{Namespace: from.ConfigMapRef.Namespace, Name: from.ConfigMapRef.Name}
+			if err := c.TrackAndGet(ctx, key, configMap); err != nil {
+				return nil, fmt.Errorf("failed to get ConfigMap %q: %w", key, err)
+			}
+			maps.Copy(resolved, configMap.Data)
+		case from.SecretRef != nil:
+			secret := &corev1.Secret{}
+			key := types.NamespacedName{Namespace: from.SecretRef.Namespace, Name: from.SecretRef.Name}
+			if err := c.TrackAndGet(ctx, key, secret); err != nil {
+				return nil, fmt.Errorf("failed to get Secret %q: %w", key, err)
+			}
+			for k, v := range secret.Data {
+				resolved[k] = string(v)
+			}
+		}
+	}
+	maps.Copy(resolved, params.Values)
+	maps.Copy(resolved, shared)
+	if params.NamespaceOverrides != "" {
+		// the overrides are optional, tracking them applies the overrides once created
+		overrides := &corev1.ConfigMap{}
+		key := types.NamespacedName{Namespace: parent.Namespace, Name: params.NamespaceOverrides}
+		if err := c.TrackAndGet(ctx, key, overrides); err != nil {
+			if !apierrs.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get ConfigMap %q: %w", key, err)
+			}
+		} else {
+			maps.Copy(resolved, overrides.Data)
+		}
+	}
+	return resolved, nil
+}
+
+// resolveResources lists the resources declared by the convention in the PodIntent's namespace,
+// keeping those with a matching name. The lists are tracked so the PodIntent is reconciled when
+// the resources change.
+func resolveResources(ctx context.Context, c reconcilers.Config, resources []conventionsv1alpha1.ClusterPodConventionResource, parent *conventionsv1alpha1.PodIntent) ([]unstructured.Unstructured, error) {
+	var resolved []unstructured.Unstructured
+	for _, resource := range resources {
+		items, err := listResources(ctx, c, resource.Kind, parent.Namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s %s: %w", resource.APIVersion, resource.Kind, err)
+		}
+		for _, item := range items {
+			if matched, _ := path.Match(resource.Name, item.GetName()); !matched {
+				continue
+			}
+			item.SetManagedFields(nil)
+			if resource.MetadataOnly || item.GroupVersionKind().GroupKind() == secretGVK.GroupKind() {
+				item = metadataOnly(item)
+			}
+			resolved = append(resolved, item)
+		}
+	}
+	return resolved, nil
+}
+
+// listResources lists the core resources of the kind in the namespace. ConfigMaps and LimitRanges
+// are read from the cache. Secrets are never cached by the manager's client, so they are listed
+// from the API server, but only as metadata, which is all that is sent for them.
+func listResources(ctx context.Context, c reconcilers.Config, kind, namespace string) ([]unstructured.Unstructured, error) {
+	var list client.ObjectList
+	switch kind {
+	case "ConfigMap":
+		list = &corev1.ConfigMapList{}
+	case "LimitRange":
+		list = &corev1.LimitRangeList{}
+	case secretGVK.Kind:
+		secrets := &metav1.PartialObjectMetadataList{}
+		secrets.SetGroupVersionKind(secretGVK.GroupVersion().WithKind(secretGVK.Kind + "List"))
+		list = secrets
+	default:
+		// rejected by the ClusterPodConvention's validation
+		return nil, fmt.Errorf("unsupported kind %q", kind)
+	}
+	if err := c.TrackAndList(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	objs, err := apimeta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]unstructured.Unstructured, len(objs))
+	for i, obj := range objs {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = unstructured.Unstructured{Object: content}
+		// objects read from the cache have no type meta
+		items[i].SetAPIVersion(corev1.SchemeGroupVersion.String())
+		items[i].SetKind(kind)
+	}
+	return items, nil
+}
+
+// metadataOnly drops everything but the metadata of the resource, including the last applied
+// configuration which holds a copy of the resource.
+func metadataOnly(obj unstructured.Unstructured) unstructured.Unstructured {
+	meta := unstructured.Unstructured{Object: map[string]interface{}{}}
+	meta.SetAPIVersion(obj.GetAPIVersion())
+	meta.SetKind(obj.GetKind())
+	meta.Object["metadata"] = obj.Object["metadata"]
+	if annotations := meta.GetAnnotations(); annotations != nil {
+		delete(annotations, corev1.LastAppliedConfigAnnotation)
+		meta.SetAnnotations(annotations)
+	}
+	return meta
+}
+
 func ApplyConventionsReconciler(wc binding.WebhookConfig) reconcilers.SubReconciler[*conventionsv1alpha1.PodIntent] {
+	backoff := newRequeueBackoff()
+	outcomes := newRecordedOutcomes()
 	return &reconcilers.SyncReconciler[*conventionsv1alpha1.PodIntent]{
 		Name: "ApplyConventions",
 		SyncWithResult: func(ctx context.Context, parent *conventionsv1alpha1.PodIntent) (ctrl.Result, error) {
+			ctx, span := startSpan(ctx, "ApplyConventions", parent)
+			defer span.End()
 			log := logr.FromContextOrDiscard(ctx)
+			c := reconcilers.RetrieveConfigOrDie(ctx)
 
 			sources := RetrieveConventions(ctx)
 			workload := &parent.Spec.Template
+			previous := parent.Status.Template
+			if parent.IsDryRun() && parent.Status.Preview != nil {
+				// an unreadable preview is replaced, as if the template changed
+				previous, _ = parent.Status.Preview.PodTemplate()
+			}
+			// the preview is only reported when conventions apply during a dry run
+			parent.Status.Preview = nil
 
 			conditionManager := parent.GetConditionSet().Manage(&parent.Status)
 			conventionsAppliedCond := conditionManager.GetCondition(conventionsv1alpha1.PodIntentConditionConventionsApplied)
@@ -286,28 +571,296 @@ func ApplyConventionsReconciler(wc binding.WebhookConfig) reconcilers.SubReconci
 			collectedLabels[podIntentLabelsKey] = labels.Set(parent.ObjectMeta.GetLabels())
 			collectedLabels[podTemplateLabelsKey] = labels.Set(workload.GetLabels())
 
-			filteredAndSortedConventions, err := sources.FilterAndSort(collectedLabels)
+			filteredConventions, err := sources.Filter(collectedLabels, parent.Spec.Conventions)
 			if err != nil {
 				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "LabelSelector", "filtering conventions failed: %v", err.Error())
 				log.Error(err, "failed to filter conventions")
 				return ctrl.Result{}, nil
 			}
+			filteredAndSortedConventions, err := filteredConventions.Sort()
+			if err != nil {
+				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ConventionOrdering", "ordering conventions failed: %v", err.Error())
+				log.Error(err, "failed to order conventions")
+				return ctrl.Result{}, nil
+			}
 			if workload.Annotations == nil {
 				workload.Annotations = map[string]string{}
 			}
-			updatedWorkload, err := filteredAndSortedConventions.Apply(ctx, parent, wc, RetrieveRegistryConfig(ctx))
+			mutating := filteredAndSortedConventions.Mutating()
+			validating := filteredAndSortedConventions.Validating()
+			updatedWorkload, results, err := mutating.Apply(ctx, parent, wc, RetrieveRegistryConfig(ctx))
+			failed, failedResults := mutating, results
+			var validations []binding.ConventionResult
+			if err == nil {
+				validations, err = validating.Validate(ctx, parent, updatedWorkload, wc, RetrieveRegistryConfig(ctx))
+				failed, failedResults = validating, validations
+			}
+			// retries and resyncs of an unchanged PodIntent repeat the same outcome, only record
+			// events when the outcome changes
+			outcome := conventionsOutcome(validations, err)
+			if outcomes.changed(parent, outcome) || (outcome == "" && !equality.Semantic.DeepEqual(previous, conventionsv1alpha1.NewPodTemplateSpec(updatedWorkload))) {
+				recordSkippedConventions(c, parent, sources, filteredAndSortedConventions)
+				recordAppliedConventions(c, parent, results)
+				recordValidatedConventions(c, parent, validations)
+				if err != nil {
+					recordFailedConvention(c, parent, failed, failedResults, err)
+				}
+			}
 			if err != nil {
-				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ConventionsApplied", "%v", err.Error())
-				return ctrl.Result{Requeue: true}, nil
+				return markConventionsFailed(conditionManager, backoff, parent, err), nil
+			}
+			backoff.reset(parent)
+			parent.Status.Warnings = nil
+			for _, result := range append(results, validations...) {
+				for _, warning := range result.Warnings {
+					parent.Status.Warnings = append(parent.Status.Warnings, conventionsv1alpha1.PodIntentWarning{Convention: result.Name, Message: warning})
+				}
+				for _, conflict := range result.Conflicts {
+					parent.Status.Warnings = append(parent.Status.Warnings, conventionsv1alpha1.PodIntentWarning{Convention: result.Name, Message: conflict.String()})
+				}
+			}
+			for _, convention := range filteredAndSortedConventions {
+				if convention.Mandatory && (!parent.Spec.Conventions.Includes(convention.Name) || len(parent.Spec.Conventions.ExcludedWithin(convention.Name)) != 0) {
+					parent.Status.Warnings = append(parent.Status.Warnings, conventionsv1alpha1.PodIntentWarning{Convention: convention.Name, Message: "the convention is mandatory and cannot be excluded"})
+				}
+			}
+			if parent.IsDryRun() {
+				if parent.Status.Preview, err = newPodIntentPreview(updatedWorkload, results); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			if outcome != "" {
+				// the template is not published until it is allowed, the PodIntent must change first
+				conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "PolicyDenied", "denied by conventions %s", outcome)
+				return ctrl.Result{}, nil
+			}
+			if parent.IsDryRun() {
+				// consumers must not treat the preview as the result of the PodIntent
+				conditionManager.MarkUnknown(conventionsv1alpha1.PodIntentConditionConventionsApplied, "DryRun", "conventions applied to the preview, remove the %s annotation to publish the template", conventionsv1alpha1.DryRunAnnotationKey)
+				return ctrl.Result{}, nil
 			}
 			parent.Status.Template = conventionsv1alpha1.NewPodTemplateSpec(updatedWorkload)
-			conditionManager.MarkTrue(conventionsv1alpha1.PodIntentConditionConventionsApplied, "Applied", "")
+			stripped := []string{}
+			for _, result := range results {
+				if len(result.StrippedPaths) != 0 {
+					stripped = append(stripped, fmt.Sprintf("%s: %s", result.Name, strings.Join(result.StrippedPaths, ", ")))
+				}
+			}
+			if len(stripped) != 0 {
+				conditionManager.MarkTrue(conventionsv1alpha1.PodIntentConditionConventionsApplied, "Applied", "stripped changes that are not allowed from conventions %s", strings.Join(stripped, "; "))
+			} else {
+				conditionManager.MarkTrue(conventionsv1alpha1.PodIntentConditionConventionsApplied, "Applied", "")
+			}
 
 			return ctrl.Result{}, nil
 		},
 	}
 }
 
+// newPodIntentPreview reports the template resulting from the conventions, and the fields each
+// convention changed.
+func newPodIntentPreview(template *corev1.PodTemplateSpec, results []binding.ConventionResult) (*conventionsv1alpha1.PodIntentPreview, error) {
+	raw, err := json.Marshal(conventionsv1alpha1.NewPodTemplateSpec(template))
+	if err != nil {
+		return nil, err
+	}
+	preview := &conventionsv1alpha1.PodIntentPreview{
+		Template: &apiextensionsv1.JSON{Raw: raw},
+	}
+	for _, result := range results {
+		convention := conventionsv1alpha1.PodIntentPreviewConvention{Name: result.Name}
+		for _, change := range result.Changes {
+			convention.Changes = append(convention.Changes, conventionsv1alpha1.PodIntentPreviewChange{
+				Field:  change.Path,
+				Before: previewValue(change.Before),
+				After:  previewValue(change.After),
+			})
+		}
+		preview.Conventions = append(preview.Conventions, convention)
+	}
+	return preview, nil
+}
+
+func previewValue(value []byte) *apiextensionsv1.JSON {
+	if value == nil {
+		return nil
+	}
+	return &apiextensionsv1.JSON{Raw: value}
+}
+
+// markConventionsFailed sets the reason for the failure to apply or validate with a convention,
+// and when to try again, if at all.
+func markConventionsFailed(conditionManager apis.ConditionManager, backoff requeueBackoff, parent *conventionsv1alpha1.PodIntent, err error) ctrl.Result {
+	var circuitErr *binding.CircuitOpenError
+	if errors.As(err, &circuitErr) {
+		// fast-failed without calling the convention, wait for the circuit to allow a trial request
+		conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ConventionCircuitOpen", "%v", err.Error())
+		return ctrl.Result{RequeueAfter: max(time.Until(circuitErr.RetryTime), time.Second)}
+	}
+	var mutationErr *binding.MutationError
+	if errors.As(err, &mutationErr) {
+		// the convention will keep making the same changes until it, or its allowed scopes, are updated
+		conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "MutationNotAllowed", "%v", err.Error())
+		return ctrl.Result{}
+	}
+	var conflictErr *binding.ConflictError
+	if errors.As(err, &conflictErr) {
+		// the conventions will keep writing the same fields until they, or their exclusive fields, are updated
+		conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, "ConventionConflict", "%v", err.Error())
+		return ctrl.Result{}
+	}
+	class := binding.ClassOf(err)
+	if class == binding.ConventionRejectedClass {
+		// the convention refused the workload, retrying the same request will not help
+		conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, string(class), "%v", err.Error())
+		return ctrl.Result{}
+	}
+	conditionManager.MarkFalse(conventionsv1alpha1.PodIntentConditionConventionsApplied, string(class), "%v", err.Error())
+	return ctrl.Result{RequeueAfter: backoff.next(class, parent)}
+}
+
+// formatDenials joins the reasons a convention denied the template.
+func formatDenials(denials []webhookv1alpha1.ValidationReason) string {
+	reasons := make([]string, len(denials))
+	for i, denial := range denials {
+		reasons[i] = denial.String()
+	}
+	return strings.Join(reasons, ", ")
+}
+
+// tracer uses the global provider, spans are dropped unless an exporter is configured.
+var tracer = otel.Tracer("github.com/vmware-tanzu/cartographer-conventions/pkg/controllers")
+
+// startSpan starts a span for a step of the PodIntent reconcile.
+func startSpan(ctx context.Context, name string, parent *conventionsv1alpha1.PodIntent) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(
+		attribute.String("podintent.namespace", parent.Namespace),
+		attribute.String("podintent.name", parent.Name),
+	))
+}
+
+// recordSkippedConventions emits an event listing the conventions whose selectors did not match
+// the PodIntent, and one listing the conventions that the PodIntent excluded.
+func recordSkippedConventions(c reconcilers.Config, parent *conventionsv1alpha1.PodIntent, sources, matched binding.Conventions) {
+	unmatched := map[string]int{}
+	for _, convention := range sources {
+		unmatched[convention.Name]++
+	}
+	for _, convention := range matched {
+		unmatched[convention.Name]--
+	}
+	excluded := []string{}
+	mismatched := []string{}
+	for _, convention := range sources {
+		if unmatched[convention.Name] > 0 {
+			unmatched[convention.Name]--
+			if !convention.Mandatory && !parent.Spec.Conventions.Includes(convention.Name) {
+				excluded = append(excluded, convention.Name)
+				continue
+			}
+			mismatched = append(mismatched, convention.Name)
+		}
+	}
+	if len(excluded) != 0 {
+		c.Recorder.Eventf(parent, corev1.EventTypeNormal, "ConventionSkipped", "Skipped conventions excluded by the PodIntent: %s", strings.Join(excluded, ", "))
+	}
+	if len(mismatched) != 0 {
+		c.Recorder.Eventf(parent, corev1.EventTypeNormal, "ConventionSkipped", "Skipped conventions whose selectors did not match: %s", strings.Join(mismatched, ", "))
+	}
+}
+
+// recordAppliedConventions emits an event for each convention applied, for any changes
+// stripped from the convention's response, and for any fields overwritten from earlier conventions.
+func recordAppliedConventions(c reconcilers.Config, parent *conventionsv1alpha1.PodIntent, results []binding.ConventionResult) {
+	for _, result := range results {
+		c.Recorder.Eventf(parent, corev1.EventTypeNormal, "ConventionApplied", "Applied convention %s", result.Name)
+		if len(result.StrippedPaths) != 0 {
+			c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ConventionMutationStripped", "Stripped changes that are not allowed from convention %s: %s", result.Name, strings.Join(result.StrippedPaths, ", "))
+		}
+		for _, conflict := range result.Conflicts {
+			c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ConventionConflict", "Convention %s %s", result.Name, conflict)
+		}
+		for _, warning := range result.Warnings {
+			c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ConventionWarning", "Convention %s: %s", result.Name, warning)
+		}
+	}
+}
+
+// recordValidatedConventions emits an event for each validating convention called, with the
+// reasons the template was denied, if any.
+func recordValidatedConventions(c reconcilers.Config, parent *conventionsv1alpha1.PodIntent, results []binding.ConventionResult) {
+	for _, result := range results {
+		if len(result.Denials) != 0 {
+			c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ConventionDenied", "Convention %s denied the template: %s", result.Name, formatDenials(result.Denials))
+		} else {
+			c.Recorder.Eventf(parent, corev1.EventTypeNormal, "ConventionValidated", "Convention %s allowed the template", result.Name)
+		}
+		for _, warning := range result.Warnings {
+			c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ConventionWarning", "Convention %s: %s", result.Name, warning)
+		}
+	}
+}
+
+// conventionsOutcome describes the failure of the conventions, or the denials of the validating
+// conventions. The outcome of conventions that applied and allowed the template is empty.
+func conventionsOutcome(validations []binding.ConventionResult, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	denied := []string{}
+	for _, result := range validations {
+		if len(result.Denials) != 0 {
+			denied = append(denied, fmt.Sprintf("%s: %s", result.Name, formatDenials(result.Denials)))
+		}
+	}
+	return strings.Join(denied, "; ")
+}
+
+// recordedOutcomes remembers, per PodIntent, the last outcome other than success that events
+// were recorded for. Conditions are reset at the start of each reconcile, so the previous outcome
+// is not available from the status. A forgotten PodIntent records its events again.
+type recordedOutcomes struct {
+	failures *lru.Cache
+}
+
+func newRecordedOutcomes() recordedOutcomes {
+	return recordedOutcomes{failures: lru.New(4096)}
+}
+
+// changed stores the outcome for the PodIntent, reporting whether it differs from the previous
+// outcome.
+func (o recordedOutcomes) changed(parent *conventionsv1alpha1.PodIntent, outcome string) bool {
+	id := backoffID(parent)
+	value, _ := o.failures.Get(id)
+	previous, _ := value.(string)
+	if outcome == "" {
+		o.failures.Remove(id)
+	} else {
+		o.failures.Add(id, outcome)
+	}
+	return previous != outcome
+}
+
+// recordFailedConvention emits an event for the convention that failed. Conventions are applied
+// in order, so the failed convention directly follows the last convention with a result.
+func recordFailedConvention(c reconcilers.Config, parent *conventionsv1alpha1.PodIntent, conventions binding.Conventions, results []binding.ConventionResult, err error) {
+	name := "<unknown>"
+	if len(results) < len(conventions) {
+		name = conventions[len(results)].Name
+	}
+	var circuitErr *binding.CircuitOpenError
+	if errors.As(err, &circuitErr) {
+		c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ConventionCircuitOpen", "Skipped convention %s: %v", name, circuitErr)
+		return
+	}
+	var imageErr binding.ImageError
+	if errors.As(err, &imageErr) {
+		c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ImageResolutionFailed", "Failed to resolve images for convention %s: %v", name, imageErr)
+		return
+	}
+	c.Recorder.Eventf(parent, corev1.EventTypeWarning, "ConventionFailed", "Failed to apply convention %s: %v", name, err)
+}
+
 const (
 	ConventionsStashKey reconcilers.StashKey = "conventions.carto.run/Conventions"
 	RegistryConfigKey   reconcilers.StashKey = "conventions.carto.run/RegistryConfig"
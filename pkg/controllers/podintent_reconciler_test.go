@@ -33,6 +33,7 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/cache"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -424,11 +425,13 @@ func TestBuildRegistryConfig(t *testing.T) {
 			},
 		},
 		"ServiceAccount not present in namespace": {
-			Request: request,
+			Request:        request,
+			ExpectedResult: reconcile.Result{RequeueAfter: 30 * time.Second},
 			StatusSubResourceTypes: []client.Object{
 				&conventionsv1alpha1.PodIntent{},
 			},
 			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(parent, scheme, corev1.EventTypeWarning, "ImageResolutionFailed", `Failed to authenticate with image registries: serviceaccounts "wrong-sa" not found`),
 				rtesting.NewEvent(parent, scheme, corev1.EventTypeNormal, "StatusUpdated", `Updated status`),
 			},
 			GivenObjects: []client.Object{
@@ -462,11 +465,11 @@ func TestBuildRegistryConfig(t *testing.T) {
 						d.ConditionsDie(
 							dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
 								Status(metav1.ConditionFalse).
-								Reason("ImageResolutionFailed").
+								Reason("RegistryAuthFailed").
 								Message("failed to authenticate: serviceaccounts \"wrong-sa\" not found"),
 							dieconventionsv1alpha1.PodIntentConditionReadyBlank.
 								Status(metav1.ConditionFalse).
-								Reason("ImageResolutionFailed").
+								Reason("RegistryAuthFailed").
 								Message("failed to authenticate: serviceaccounts \"wrong-sa\" not found"),
 						)
 					}),
@@ -480,11 +483,13 @@ func TestBuildRegistryConfig(t *testing.T) {
 			},
 		},
 		"ServiceAccount not present in api reader(unlikely)": {
-			Request: request,
+			Request:        request,
+			ExpectedResult: reconcile.Result{RequeueAfter: 30 * time.Second},
 			StatusSubResourceTypes: []client.Object{
 				&conventionsv1alpha1.PodIntent{},
 			},
 			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(parent, scheme, corev1.EventTypeWarning, "ImageResolutionFailed", `Failed to authenticate with image registries: serviceaccounts "test-sa-with-secret" not found`),
 				rtesting.NewEvent(parent, scheme, corev1.EventTypeNormal, "StatusUpdated", `Updated status`),
 			},
 			GivenObjects: []client.Object{
@@ -516,11 +521,11 @@ func TestBuildRegistryConfig(t *testing.T) {
 						d.ConditionsDie(
 							dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
 								Status(metav1.ConditionFalse).
-								Reason("ImageResolutionFailed").
+								Reason("RegistryAuthFailed").
 								Message("failed to authenticate: serviceaccounts \"test-sa-with-secret\" not found"),
 							dieconventionsv1alpha1.PodIntentConditionReadyBlank.
 								Status(metav1.ConditionFalse).
-								Reason("ImageResolutionFailed").
+								Reason("RegistryAuthFailed").
 								Message("failed to authenticate: serviceaccounts \"test-sa-with-secret\" not found"),
 						)
 					}),
@@ -645,6 +650,114 @@ func TestResolveConventions(t *testing.T) {
 			d.Name(anotherTestName)
 		})
 
+	paramsConfigMap := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("conventions")
+			d.Name("defaults")
+		}).
+		Data(map[string]string{
+			"jvm.memory-ratio":   "0.75",
+			"probes.health-path": "/livez",
+			"sidecar.image":      "registry.example.com/sidecar",
+		})
+	paramsSecret := diecorev1.SecretBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("conventions")
+			d.Name("credentials")
+		}).
+		Data(map[string][]byte{
+			"registry.token": []byte("secret-token"),
+		})
+	paramsOverrides := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.Name("conventions-params")
+		}).
+		Data(map[string]string{
+			"jvm.memory-ratio": "0.5",
+		})
+
+	profile := dieconventionsv1alpha1.ConventionProfileBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("web-apps")
+		}).
+		SpecDie(func(d *dieconventionsv1alpha1.ConventionProfileSpecDie) {
+			d.Conventions(testName, anotherTestName)
+			d.AddParam("jvm.memory-ratio", "0.5")
+		})
+	profileConventions := []client.Object{
+		testConvention.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.CreationTimestamp(now)
+			}).
+			SpecDie(func(d *dieconventionsv1alpha1.ClusterPodConventionSpecDie) {
+				d.Selectors(metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}})
+				d.WebookDie(func(d *dieconventionsv1alpha1.ClusterPodConventionWebhookDie) {
+					d.ClientConfig(admissionregistrationv1.WebhookClientConfig{URL: &url})
+				})
+			}),
+		anotherTestConvention.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.CreationTimestamp(now)
+			}).
+			SpecDie(func(d *dieconventionsv1alpha1.ClusterPodConventionSpecDie) {
+				d.WebookDie(func(d *dieconventionsv1alpha1.ClusterPodConventionWebhookDie) {
+					d.ClientConfig(admissionregistrationv1.WebhookClientConfig{URL: &url})
+				})
+				d.ParamsDie(func(d *dieconventionsv1alpha1.ClusterPodConventionParamsDie) {
+					d.AddValue("jvm.memory-ratio", "0.75")
+					d.AddValue("probes.health-path", "/healthz")
+				})
+			}),
+		dieconventionsv1alpha1.ClusterPodConventionBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name("ignored-convention")
+				d.CreationTimestamp(now)
+			}).
+			SpecDie(func(d *dieconventionsv1alpha1.ClusterPodConventionSpecDie) {
+				d.WebookDie(func(d *dieconventionsv1alpha1.ClusterPodConventionWebhookDie) {
+					d.ClientConfig(admissionregistrationv1.WebhookClientConfig{URL: &url})
+				})
+			}),
+		dieconventionsv1alpha1.ClusterPodConventionBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name("mandatory-convention")
+				d.CreationTimestamp(now)
+			}).
+			SpecDie(func(d *dieconventionsv1alpha1.ClusterPodConventionSpecDie) {
+				d.Mandatory(true)
+				d.WebookDie(func(d *dieconventionsv1alpha1.ClusterPodConventionWebhookDie) {
+					d.ClientConfig(admissionregistrationv1.WebhookClientConfig{URL: &url})
+				})
+			}),
+	}
+	profileStash := []binding.Convention{
+		{
+			Name:         anotherTestName,
+			Priority:     conventionsv1alpha1.NormalPriority,
+			RunAfter:     []string{testName},
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{URL: &url},
+			Params: map[string]string{
+				"jvm.memory-ratio":   "0.5",
+				"probes.health-path": "/healthz",
+			},
+		},
+		{
+			Name:         "mandatory-convention",
+			Priority:     conventionsv1alpha1.NormalPriority,
+			Mandatory:    true,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{URL: &url},
+		},
+		{
+			Name:         testName,
+			Priority:     conventionsv1alpha1.NormalPriority,
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{URL: &url},
+			Params: map[string]string{
+				"jvm.memory-ratio": "0.5",
+			},
+		},
+	}
+
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = conventionsv1alpha1.AddToScheme(scheme)
@@ -698,6 +811,140 @@ func TestResolveConventions(t *testing.T) {
 					}},
 			},
 		},
+		"resolve params": {
+			Resource: parent.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				paramsConfigMap,
+				paramsSecret,
+				paramsOverrides,
+				testConvention.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.CreationTimestamp(now)
+					}).
+					SpecDie(func(d *dieconventionsv1alpha1.ClusterPodConventionSpecDie) {
+						d.WebookDie(func(d *dieconventionsv1alpha1.ClusterPodConventionWebhookDie) {
+							d.ClientConfig(admissionregistrationv1.WebhookClientConfig{URL: &url})
+						})
+						d.ParamsDie(func(d *dieconventionsv1alpha1.ClusterPodConventionParamsDie) {
+							d.AddValue("probes.health-path", "/healthz")
+							d.FromDie(
+								dieconventionsv1alpha1.ClusterPodConventionParamsSourceBlank.
+									ConfigMapRefDie(func(d *dieconventionsv1alpha1.ClusterPodConventionParamsReferenceDie) {
+										d.Namespace("conventions")
+										d.Name("defaults")
+									}),
+								dieconventionsv1alpha1.ClusterPodConventionParamsSourceBlank.
+									SecretRefDie(func(d *dieconventionsv1alpha1.ClusterPodConventionParamsReferenceDie) {
+										d.Namespace("conventions")
+										d.Name("credentials")
+									}),
+							)
+							d.NamespaceOverrides("conventions-params")
+						})
+					}),
+			},
+			ExpectResource: parent.DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(paramsConfigMap, parent, scheme),
+				rtesting.NewTrackRequest(paramsSecret, parent, scheme),
+				rtesting.NewTrackRequest(paramsOverrides, parent, scheme),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:         testName,
+						Priority:     conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{URL: &url},
+						Params: map[string]string{
+							"jvm.memory-ratio":   "0.5",
+							"probes.health-path": "/healthz",
+							"sidecar.image":      "registry.example.com/sidecar",
+							"registry.token":     "secret-token",
+						},
+					},
+				},
+			},
+		},
+		"namespace overrides are optional": {
+			Resource: parent.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				testConvention.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.CreationTimestamp(now)
+					}).
+					SpecDie(func(d *dieconventionsv1alpha1.ClusterPodConventionSpecDie) {
+						d.WebookDie(func(d *dieconventionsv1alpha1.ClusterPodConventionWebhookDie) {
+							d.ClientConfig(admissionregistrationv1.WebhookClientConfig{URL: &url})
+						})
+						d.ParamsDie(func(d *dieconventionsv1alpha1.ClusterPodConventionParamsDie) {
+							d.AddValue("jvm.memory-ratio", "0.75")
+							d.NamespaceOverrides("conventions-params")
+						})
+					}),
+			},
+			ExpectResource: parent.DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(paramsOverrides, parent, scheme),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:         testName,
+						Priority:     conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{URL: &url},
+						Params: map[string]string{
+							"jvm.memory-ratio": "0.75",
+						},
+					},
+				},
+			},
+		},
+		"params source not found": {
+			Resource: parent.DieReleasePtr(),
+			GivenObjects: []client.Object{
+				testConvention.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.CreationTimestamp(now)
+					}).
+					SpecDie(func(d *dieconventionsv1alpha1.ClusterPodConventionSpecDie) {
+						d.WebookDie(func(d *dieconventionsv1alpha1.ClusterPodConventionWebhookDie) {
+							d.ClientConfig(admissionregistrationv1.WebhookClientConfig{URL: &url})
+						})
+						d.ParamsDie(func(d *dieconventionsv1alpha1.ClusterPodConventionParamsDie) {
+							d.FromDie(
+								dieconventionsv1alpha1.ClusterPodConventionParamsSourceBlank.
+									ConfigMapRefDie(func(d *dieconventionsv1alpha1.ClusterPodConventionParamsReferenceDie) {
+										d.Namespace("conventions")
+										d.Name("defaults")
+									}),
+							)
+						})
+					}),
+			},
+			ExpectResource: parent.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionFalse).
+							Reason("ParamsResolutionFailed").
+							Message(`failed to resolve params: failed to get ConfigMap "conventions/defaults": configmaps "defaults" not found`),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionFalse).
+							Reason("ParamsResolutionFailed").
+							Message(`failed to resolve params: failed to get ConfigMap "conventions/defaults": configmaps "defaults" not found`),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(paramsConfigMap, parent, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(parent, scheme, corev1.EventTypeWarning, "ParamsResolutionFailed", `Failed to resolve params for convention test-convention: failed to get ConfigMap "conventions/defaults": configmaps "defaults" not found`),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ConventionsStashKey: nil,
+			},
+		},
 		"error loading conventions": {
 			Resource: parent.DieReleasePtr(),
 			GivenObjects: []client.Object{
@@ -857,6 +1104,9 @@ func TestResolveConventions(t *testing.T) {
 					)
 				}).
 				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(parent, scheme, corev1.EventTypeWarning, "CABundleResolutionFailed", `Failed to resolve CA bundle for convention test-convention: unable to find valid "CertificateRequests" for certificate "ns/wrong-ca" configured in convention "test-convention"`),
+			},
 			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.ConventionsStashKey: nil,
 			},
@@ -898,6 +1148,9 @@ func TestResolveConventions(t *testing.T) {
 					)
 				}).
 				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(parent, scheme, corev1.EventTypeWarning, "CABundleResolutionFailed", `Failed to resolve CA bundle for convention test-convention: unable to find valid "CertificateRequests" for certificate "test-namespace/my-cert" configured in convention "test-convention"`),
+			},
 			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.ConventionsStashKey: nil,
 			},
@@ -941,6 +1194,9 @@ func TestResolveConventions(t *testing.T) {
 					)
 				}).
 				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(parent, scheme, corev1.EventTypeWarning, "CABundleResolutionFailed", `Failed to resolve CA bundle for convention test-convention: unable to find valid "CertificateRequests" for certificate "test-namespace/my-cert" configured in convention "test-convention"`),
+			},
 			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.ConventionsStashKey: nil,
 			},
@@ -984,6 +1240,83 @@ func TestResolveConventions(t *testing.T) {
 					)
 				}).
 				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(parent, scheme, corev1.EventTypeWarning, "CABundleResolutionFailed", `Failed to resolve CA bundle for convention test-convention: unable to find valid "CertificateRequests" for certificate "test-namespace/my-cert" configured in convention "test-convention"`),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ConventionsStashKey: nil,
+			},
+		},
+		"profile selected by the PodIntent": {
+			Resource: parent.
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.ConventionsDie(func(d *dieconventionsv1alpha1.PodIntentConventionsDie) {
+						d.Profile("web-apps")
+					})
+				}).
+				DieReleasePtr(),
+			GivenObjects: append([]client.Object{profile}, profileConventions...),
+			ExpectResource: parent.
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.ConventionsDie(func(d *dieconventionsv1alpha1.PodIntentConventionsDie) {
+						d.Profile("web-apps")
+					})
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(profile, parent, scheme),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ConventionsStashKey: profileStash,
+			},
+		},
+		"profile selected by the namespace": {
+			Resource: parent.DieReleasePtr(),
+			GivenObjects: append([]client.Object{
+				profile,
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(namespace)
+						d.AddLabel(conventionsv1alpha1.ProfileLabelKey, "web-apps")
+					}),
+			}, profileConventions...),
+			ExpectResource: parent.DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(profile, parent, scheme),
+			},
+			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.ConventionsStashKey: profileStash,
+			},
+		},
+		"profile not found": {
+			Resource: parent.DieReleasePtr(),
+			GivenObjects: append([]client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(namespace)
+						d.AddLabel(conventionsv1alpha1.ProfileLabelKey, "web-apps")
+					}),
+			}, profileConventions...),
+			ExpectResource: parent.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionFalse).
+							Reason("ProfileResolutionFailed").
+							Message(`failed to resolve profile: failed to get ConventionProfile "web-apps": conventionprofiles.conventions.carto.run "web-apps" not found`),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionFalse).
+							Reason("ProfileResolutionFailed").
+							Message(`failed to resolve profile: failed to get ConventionProfile "web-apps": conventionprofiles.conventions.carto.run "web-apps" not found`),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectTracks: []rtesting.TrackRequest{
+				rtesting.NewTrackRequest(profile, parent, scheme),
+			},
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(parent, scheme, corev1.EventTypeWarning, "ProfileResolutionFailed", `Failed to resolve convention profile: failed to get ConventionProfile "web-apps": conventionprofiles.conventions.carto.run "web-apps" not found`),
+			},
 			ExpectStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.ConventionsStashKey: nil,
 			},
@@ -1125,52 +1458,52 @@ func TestApplyConventionsReconciler(t *testing.T) {
 					)
 				}).
 				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+			},
 		},
-		"selector target and matcher defined matcheslabels in podTemplateSpec values": {
+		"resync with an unchanged template": {
 			Resource: workload.
-				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
 					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
 						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-							d.AddLabel("foo", "bar")
-							d.AddLabel("zoo", "zebra")
+							d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "my-conventions/test-convention/default-label")
+						})
+						d.SpecDie(func(d *diecorev1.PodSpecDie) {
+							d.ContainerDie("test-workload", func(d *diecorev1.ContainerDie) {
+								d.Image("ubuntu")
+								d.EnvDie("KEY", func(d *diecorev1.EnvVarDie) {
+									d.Value("VALUE")
+								})
+							})
 						})
 					})
 				}).
-				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-					d.AddLabel("environment", "development")
-				}).
 				DieReleasePtr(),
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.RegistryConfigKey: rc,
 				controllers.ConventionsStashKey: []binding.Convention{
 					{
-						Name:           testConventions,
-						SelectorTarget: "PodTemplateSpec",
-						Selectors: []metav1.LabelSelector{{
-							MatchLabels: map[string]string{"foo": "bar"},
-						}},
-						Priority: conventionsv1alpha1.EarlyPriority,
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
 						ClientConfig: admissionregistrationv1.WebhookClientConfig{
 							Service: &admissionregistrationv1.ServiceReference{
 								Namespace: "default",
 								Name:      "webhook-test",
-								Path:      pointer.String(fmt.Sprintf("hellosidecar;host=%s", registryUrl.Host)),
 							},
 							CABundle: caCert,
 						},
 					},
 					{
-						Name:           testConventions,
-						SelectorTarget: "PodIntent",
+						Name:     "mismatch-convention-label",
+						Priority: conventionsv1alpha1.NormalPriority,
 						Selectors: []metav1.LabelSelector{{
-							MatchLabels: map[string]string{"non-matching": "development"},
+							MatchLabels: map[string]string{"mismatch": "label"},
 						}},
-						Priority: conventionsv1alpha1.EarlyPriority,
 						ClientConfig: admissionregistrationv1.WebhookClientConfig{
 							Service: &admissionregistrationv1.ServiceReference{
 								Namespace: "default",
-								Name:      "non-matching-webhook",
-								Path:      pointer.String(fmt.Sprintf("hellosidecar;host=%s", registryUrl.Host)),
+								Name:      "webhook-test",
 							},
 							CABundle: caCert,
 						},
@@ -1178,28 +1511,17 @@ func TestApplyConventionsReconciler(t *testing.T) {
 				},
 			},
 			ExpectResource: workload.
-				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
-					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
-						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-							d.AddLabel("foo", "bar")
-							d.AddLabel("zoo", "zebra")
-						})
-					})
-				}).
-				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-					d.AddLabel("environment", "development")
-				}).
 				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
 					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
 						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-							d.AddLabel("foo", "bar")
-							d.AddLabel("zoo", "zebra")
-							d.AddAnnotation("conventions.carto.run/applied-conventions", "my-conventions/path/hellosidecar")
+							d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "my-conventions/test-convention/default-label")
 						})
 						d.SpecDie(func(d *diecorev1.PodSpecDie) {
-							d.ContainerDie("hellosidecar", func(d *diecorev1.ContainerDie) {
-								d.Image(fmt.Sprintf("%s/hello", registryUrl.Host))
-								d.Command("/bin/sleep", "100")
+							d.ContainerDie("test-workload", func(d *diecorev1.ContainerDie) {
+								d.Image("ubuntu")
+								d.EnvDie("KEY", func(d *diecorev1.EnvVarDie) {
+									d.Value("VALUE")
+								})
 							})
 						})
 					})
@@ -1214,53 +1536,675 @@ func TestApplyConventionsReconciler(t *testing.T) {
 				}).
 				DieReleasePtr(),
 		},
-		"multiple selector targets and matching labels exist on specified target": {
-			Resource: workload.
-				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-					d.AddLabel("intentselector", "true")
-				}).
-				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
-					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
-						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-							d.AddLabel("zoo", "zebra")
-						})
-					})
-				}).
-				DieReleasePtr(),
+		"convention warnings": {
+			Resource: workload.DieReleasePtr(),
 			GivenStashedValues: map[reconcilers.StashKey]interface{}{
 				controllers.RegistryConfigKey: rc,
 				controllers.ConventionsStashKey: []binding.Convention{
 					{
-						Name:           "zoo-conventions",
-						SelectorTarget: "PodIntent",
-						Selectors: []metav1.LabelSelector{{
-							MatchLabels: map[string]string{"intentselector": "true"},
-						}},
+						Name:     testConventions,
 						Priority: conventionsv1alpha1.NormalPriority,
 						ClientConfig: admissionregistrationv1.WebhookClientConfig{
 							Service: &admissionregistrationv1.ServiceReference{
 								Namespace: "default",
 								Name:      "webhook-test",
+								Path:      pointer.String("warning"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "my-conventions/path/warning")
+						})
+					})
+					d.WarningsDie(
+						dieconventionsv1alpha1.PodIntentWarningBlank.
+							Convention(testConventions).
+							Message("port 8080 is in use, using 8081"),
+					)
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionTrue).
+							Reason("Applied"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionTrue).
+							Reason("ConventionsApplied"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionWarning", `Convention my-conventions: port 8080 is in use, using 8081`),
+			},
+		},
+		"conflicting conventions": {
+			Resource: workload.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("owner"),
+							},
+							CABundle: caCert,
+						},
+					},
+					{
+						Name:     "zoo-conventions",
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("owner"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("owner", testName+"-zoo-conventions")
+							d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "")
+						})
+					})
+					d.WarningsDie(
+						dieconventionsv1alpha1.PodIntentWarningBlank.
+							Convention("zoo-conventions").
+							Message("overwrote .metadata.labels.owner set by convention my-conventions"),
+					)
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionTrue).
+							Reason("Applied"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionTrue).
+							Reason("ConventionsApplied"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention zoo-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionConflict", `Convention zoo-conventions overwrote .metadata.labels.owner set by convention my-conventions`),
+			},
+		},
+		"dry run": {
+			Resource: workload.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(conventionsv1alpha1.DryRunAnnotationKey, "true")
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("owner"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(conventionsv1alpha1.DryRunAnnotationKey, "true")
+				}).
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.PreviewDie(func(d *dieconventionsv1alpha1.PodIntentPreviewDie) {
+						d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+							d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+								d.AddLabel("owner", testName+"-my-conventions")
+								d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "")
+							})
+						})
+						d.ConventionsDie(
+							dieconventionsv1alpha1.PodIntentPreviewConventionBlank.
+								Name(testConventions).
+								ChangesDie(
+									dieconventionsv1alpha1.PodIntentPreviewChangeBlank.
+										Field(".metadata.labels.owner").
+										After(&apiextensionsv1.JSON{Raw: []byte(`"test-intent-my-conventions"`)}),
+								),
+						)
+					})
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionUnknown).
+							Reason("DryRun").
+							Message("conventions applied to the preview, remove the conventions.carto.run/dry-run annotation to publish the template"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionUnknown).
+							Reason("DryRun").
+							Message("conventions applied to the preview, remove the conventions.carto.run/dry-run annotation to publish the template"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+			},
+		},
+		"dry run keeps the published template": {
+			Resource: workload.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(conventionsv1alpha1.DryRunAnnotationKey, "true")
+				}).
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					// consumers wait for the Ready condition to be True before reading the template
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("owner", "published")
+						})
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("owner"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddAnnotation(conventionsv1alpha1.DryRunAnnotationKey, "true")
+				}).
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("owner", "published")
+						})
+					})
+					d.PreviewDie(func(d *dieconventionsv1alpha1.PodIntentPreviewDie) {
+						d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+							d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+								d.AddLabel("owner", testName+"-my-conventions")
+								d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "")
+							})
+						})
+						d.ConventionsDie(
+							dieconventionsv1alpha1.PodIntentPreviewConventionBlank.
+								Name(testConventions).
+								ChangesDie(
+									dieconventionsv1alpha1.PodIntentPreviewChangeBlank.
+										Field(".metadata.labels.owner").
+										After(&apiextensionsv1.JSON{Raw: []byte(`"test-intent-my-conventions"`)}),
+								),
+						)
+					})
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionUnknown).
+							Reason("DryRun").
+							Message("conventions applied to the preview, remove the conventions.carto.run/dry-run annotation to publish the template"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionUnknown).
+							Reason("DryRun").
+							Message("conventions applied to the preview, remove the conventions.carto.run/dry-run annotation to publish the template"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+			},
+		},
+		"conflicting conventions on exclusive fields": {
+			Resource: workload.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("owner"),
+							},
+							CABundle: caCert,
+						},
+						ExclusiveFields: []string{".metadata.labels"},
+					},
+					{
+						Name:     "zoo-conventions",
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("owner"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionFalse).
+							Reason("ConventionConflict").
+							Message("convention zoo-conventions conflicts on exclusive fields: overwrote .metadata.labels.owner set by convention my-conventions"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionFalse).
+							Reason("ConventionConflict").
+							Message("convention zoo-conventions conflicts on exclusive fields: overwrote .metadata.labels.owner set by convention my-conventions"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionFailed", `Failed to apply convention zoo-conventions: convention zoo-conventions conflicts on exclusive fields: overwrote .metadata.labels.owner set by convention my-conventions`),
+			},
+		},
+		"excluded conventions": {
+			Resource: workload.
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.ConventionsDie(func(d *dieconventionsv1alpha1.PodIntentConventionsDie) {
+						d.Exclude(testConventions, "zoo-conventions/run-as-non-root")
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("warning"),
+							},
+							CABundle: caCert,
+						},
+					},
+					{
+						Name:     "zoo-conventions",
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("excluded"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.ConventionsDie(func(d *dieconventionsv1alpha1.PodIntentConventionsDie) {
+						d.Exclude(testConventions, "zoo-conventions/run-as-non-root")
+					})
+				}).
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "zoo-conventions/run-as-non-root")
+						})
+					})
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionTrue).
+							Reason("Applied"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionTrue).
+							Reason("ConventionsApplied"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionSkipped", `Skipped conventions excluded by the PodIntent: my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention zoo-conventions`),
+			},
+		},
+		"mandatory conventions cannot be excluded": {
+			Resource: workload.
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.ConventionsDie(func(d *dieconventionsv1alpha1.PodIntentConventionsDie) {
+						d.Exclude(testConventions, "my-conventions/run-as-non-root")
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("excluded"),
+							},
+							CABundle: caCert,
+						},
+						Mandatory: true,
+					},
+				},
+			},
+			ExpectResource: workload.
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.ConventionsDie(func(d *dieconventionsv1alpha1.PodIntentConventionsDie) {
+						d.Exclude(testConventions, "my-conventions/run-as-non-root")
+					})
+				}).
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "")
+						})
+					})
+					d.WarningsDie(
+						dieconventionsv1alpha1.PodIntentWarningBlank.
+							Convention(testConventions).
+							Message("the convention is mandatory and cannot be excluded"),
+					)
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionTrue).
+							Reason("Applied"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionTrue).
+							Reason("ConventionsApplied"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+			},
+		},
+		"validating convention allows the template": {
+			Resource: workload.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("warning"),
+							},
+							CABundle: caCert,
+						},
+					},
+					{
+						Name:       "my-policy",
+						Priority:   conventionsv1alpha1.EarlyPriority,
+						Validating: true,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("allow"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "my-conventions/path/warning")
+						})
+					})
+					d.WarningsDie(
+						dieconventionsv1alpha1.PodIntentWarningBlank.
+							Convention(testConventions).
+							Message("port 8080 is in use, using 8081"),
+					)
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionTrue).
+							Reason("Applied"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionTrue).
+							Reason("ConventionsApplied"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionWarning", `Convention my-conventions: port 8080 is in use, using 8081`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionValidated", `Convention my-policy allowed the template`),
+			},
+		},
+		"validating convention denies the template": {
+			Resource: workload.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("warning"),
+							},
+							CABundle: caCert,
+						},
+					},
+					{
+						Name:       "my-policy",
+						Priority:   conventionsv1alpha1.NormalPriority,
+						Validating: true,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("deny"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.WarningsDie(
+						dieconventionsv1alpha1.PodIntentWarningBlank.
+							Convention(testConventions).
+							Message("port 8080 is in use, using 8081"),
+					)
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionFalse).
+							Reason("PolicyDenied").
+							Message("denied by conventions my-policy: spec.containers[0].securityContext.privileged: privileged containers are not allowed"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionFalse).
+							Reason("PolicyDenied").
+							Message("denied by conventions my-policy: spec.containers[0].securityContext.privileged: privileged containers are not allowed"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionWarning", `Convention my-conventions: port 8080 is in use, using 8081`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionDenied", `Convention my-policy denied the template: spec.containers[0].securityContext.privileged: privileged containers are not allowed`),
+			},
+		},
+		"selector target and matcher defined matcheslabels in podTemplateSpec values": {
+			Resource: workload.
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("foo", "bar")
+							d.AddLabel("zoo", "zebra")
+						})
+					})
+				}).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddLabel("environment", "development")
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:           testConventions,
+						SelectorTarget: "PodTemplateSpec",
+						Selectors: []metav1.LabelSelector{{
+							MatchLabels: map[string]string{"foo": "bar"},
+						}},
+						Priority: conventionsv1alpha1.EarlyPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String(fmt.Sprintf("hellosidecar;host=%s", registryUrl.Host)),
+							},
+							CABundle: caCert,
+						},
+					},
+					{
+						Name:           testConventions,
+						SelectorTarget: "PodIntent",
+						Selectors: []metav1.LabelSelector{{
+							MatchLabels: map[string]string{"non-matching": "development"},
+						}},
+						Priority: conventionsv1alpha1.EarlyPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "non-matching-webhook",
+								Path:      pointer.String(fmt.Sprintf("hellosidecar;host=%s", registryUrl.Host)),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("foo", "bar")
+							d.AddLabel("zoo", "zebra")
+						})
+					})
+				}).
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddLabel("environment", "development")
+				}).
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("foo", "bar")
+							d.AddLabel("zoo", "zebra")
+							d.AddAnnotation("conventions.carto.run/applied-conventions", "my-conventions/path/hellosidecar")
+						})
+						d.SpecDie(func(d *diecorev1.PodSpecDie) {
+							d.ContainerDie("hellosidecar", func(d *diecorev1.ContainerDie) {
+								d.Image(fmt.Sprintf("%s/hello", registryUrl.Host))
+								d.Command("/bin/sleep", "100")
+							})
+						})
+					})
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionTrue).
+							Reason("Applied"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionTrue).
+							Reason("ConventionsApplied"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionSkipped", `Skipped conventions whose selectors did not match: my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+			},
+		},
+		"multiple selector targets and matching labels exist on specified target": {
+			Resource: workload.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.AddLabel("intentselector", "true")
+				}).
+				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddLabel("zoo", "zebra")
+						})
+					})
+				}).
+				DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:           "zoo-conventions",
+						SelectorTarget: "PodIntent",
+						Selectors: []metav1.LabelSelector{{
+							MatchLabels: map[string]string{"intentselector": "true"},
+						}},
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+							},
+							CABundle: caCert,
+						},
+					},
+					{
+						Name:           testConventions,
+						SelectorTarget: "PodTemplateSpec",
+						Selectors: []metav1.LabelSelector{{
+							MatchLabels: map[string]string{"foo": "bar"},
+						}},
+						Priority: conventionsv1alpha1.EarlyPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String(fmt.Sprintf("hellosidecar;host=%s", registryUrl.Host)),
 							},
 							CABundle: caCert,
 						},
 					},
-					{
-						Name:           testConventions,
-						SelectorTarget: "PodTemplateSpec",
-						Selectors: []metav1.LabelSelector{{
-							MatchLabels: map[string]string{"foo": "bar"},
-						}},
-						Priority: conventionsv1alpha1.EarlyPriority,
-						ClientConfig: admissionregistrationv1.WebhookClientConfig{
-							Service: &admissionregistrationv1.ServiceReference{
-								Namespace: "default",
-								Name:      "webhook-test",
-								Path:      pointer.String(fmt.Sprintf("hellosidecar;host=%s", registryUrl.Host)),
-							},
-							CABundle: caCert,
-						},
-					},
 					{
 						Name:           "mismatch-convention-label",
 						SelectorTarget: "PodTemplateSpec",
@@ -1316,6 +2260,10 @@ func TestApplyConventionsReconciler(t *testing.T) {
 					)
 				}).
 				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionSkipped", `Skipped conventions whose selectors did not match: my-conventions, mismatch-convention-label`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention zoo-conventions`),
+			},
 		},
 		"apply all conventions if no convnetion matchers are set and no matching labels are available on the pod intent": {
 			Resource: workload.DieReleasePtr(),
@@ -1378,6 +2326,10 @@ func TestApplyConventionsReconciler(t *testing.T) {
 					)
 				}).
 				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention zoo-conventions`),
+			},
 		},
 		"bad matching expression": {
 			Resource: workload.DieReleasePtr(),
@@ -1416,6 +2368,170 @@ func TestApplyConventionsReconciler(t *testing.T) {
 				}).
 				DieReleasePtr(),
 		},
+		"ordering cycle": {
+			Resource: workload.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name: testConventions,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							URL:      &testServer.URL,
+							CABundle: caCert,
+						},
+						Priority: conventionsv1alpha1.NormalPriority,
+						RunAfter: []string{"zoo-conventions"},
+					},
+					{
+						Name: "zoo-conventions",
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							URL:      &testServer.URL,
+							CABundle: caCert,
+						},
+						Priority: conventionsv1alpha1.NormalPriority,
+						RunAfter: []string{testConventions},
+					},
+				},
+			},
+			ExpectedResult: reconcile.Result{},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionFalse).
+							Reason("ConventionOrdering").
+							Message("ordering conventions failed: unable to order ClusterPodConventions my-conventions, zoo-conventions, runAfter and runBefore references form a cycle"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionFalse).
+							Reason("ConventionOrdering").
+							Message("ordering conventions failed: unable to order ClusterPodConventions my-conventions, zoo-conventions, runAfter and runBefore references form a cycle"),
+					)
+				}).
+				DieReleasePtr(),
+		},
+		"changes not allowed": {
+			Resource: workload.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+							},
+							CABundle: caCert,
+						},
+						Mutations: &conventionsv1alpha1.ClusterPodConventionMutations{
+							AllowedScopes: []conventionsv1alpha1.MutationScope{conventionsv1alpha1.MetadataMutationScope},
+							Policy:        conventionsv1alpha1.FailMutationPolicy,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionFalse).
+							Reason("MutationNotAllowed").
+							Message("convention my-conventions made changes that are not allowed: .spec.containers"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionFalse).
+							Reason("MutationNotAllowed").
+							Message("convention my-conventions made changes that are not allowed: .spec.containers"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionFailed", `Failed to apply convention my-conventions: convention my-conventions made changes that are not allowed: .spec.containers`),
+			},
+		},
+		"panicking convention backs off": {
+			Resource: workload.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+								Path:      pointer.String("panic"),
+							},
+							CABundle: caCert,
+						},
+					},
+				},
+			},
+			ExpectedResult: reconcile.Result{RequeueAfter: 10 * time.Second},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionFalse).
+							Reason("WebhookServerError").
+							Message("failed to apply convention with name my-conventions: ConventionPanic: convention panicked: assignment to entry in nil map"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionFalse).
+							Reason("WebhookServerError").
+							Message("failed to apply convention with name my-conventions: ConventionPanic: convention panicked: assignment to entry in nil map"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionFailed", `Failed to apply convention my-conventions: failed to apply convention with name my-conventions: ConventionPanic: convention panicked: assignment to entry in nil map`),
+			},
+		},
+		"changes not allowed are stripped": {
+			Resource: workload.DieReleasePtr(),
+			GivenStashedValues: map[reconcilers.StashKey]interface{}{
+				controllers.RegistryConfigKey: rc,
+				controllers.ConventionsStashKey: []binding.Convention{
+					{
+						Name:     testConventions,
+						Priority: conventionsv1alpha1.NormalPriority,
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{
+								Namespace: "default",
+								Name:      "webhook-test",
+							},
+							CABundle: caCert,
+						},
+						Mutations: &conventionsv1alpha1.ClusterPodConventionMutations{
+							AllowedScopes: []conventionsv1alpha1.MutationScope{conventionsv1alpha1.MetadataMutationScope},
+							Policy:        conventionsv1alpha1.StripMutationPolicy,
+						},
+					},
+				},
+			},
+			ExpectResource: workload.
+				StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
+						d.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.AddAnnotation(conventionsv1alpha1.AppliedConventionsAnnotationKey, "my-conventions/test-convention/default-label")
+						})
+					})
+					d.ConditionsDie(
+						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+							Status(metav1.ConditionTrue).
+							Reason("Applied").
+							Message("stripped changes that are not allowed from conventions my-conventions: .spec.containers"),
+						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+							Status(metav1.ConditionTrue).
+							Reason("ConventionsApplied"),
+					)
+				}).
+				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeNormal, "ConventionApplied", `Applied convention my-conventions`),
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionMutationStripped", `Stripped changes that are not allowed from convention my-conventions: .spec.containers`),
+			},
+		},
 		"error applying conventions": {
 			Resource: workload.
 				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
@@ -1443,7 +2559,7 @@ func TestApplyConventionsReconciler(t *testing.T) {
 					},
 				},
 			},
-			ExpectedResult: reconcile.Result{Requeue: true},
+			ExpectedResult: reconcile.Result{RequeueAfter: 30 * time.Second},
 			ExpectResource: workload.
 				SpecDie(func(d *dieconventionsv1alpha1.PodIntentSpecDie) {
 					d.TemplateDie(func(d *diecorev1.PodTemplateSpecDie) {
@@ -1458,15 +2574,18 @@ func TestApplyConventionsReconciler(t *testing.T) {
 					d.ConditionsDie(
 						dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
 							Status(metav1.ConditionFalse).
-							Reason("ConventionsApplied").
+							Reason("RegistryAuthFailed").
 							Message("failed to fetch metadata for Images: image: \"ubuntu\" error: registry config keys are not set"),
 						dieconventionsv1alpha1.PodIntentConditionReadyBlank.
 							Status(metav1.ConditionFalse).
-							Reason("ConventionsApplied").
+							Reason("RegistryAuthFailed").
 							Message("failed to fetch metadata for Images: image: \"ubuntu\" error: registry config keys are not set"),
 					)
 				}).
 				DieReleasePtr(),
+			ExpectEvents: []rtesting.Event{
+				rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ImageResolutionFailed", `Failed to resolve images for convention my-conventions: image: "ubuntu" error: registry config keys are not set`),
+			},
 		},
 	}
 
@@ -1475,6 +2594,95 @@ func TestApplyConventionsReconciler(t *testing.T) {
 	})
 }
 
+func TestApplyConventionsReconcilerRecordsChangedOutcomes(t *testing.T) {
+	testConventions := "my-conventions"
+
+	workload := dieconventionsv1alpha1.PodIntentBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace("test-namespace")
+			d.Name("test-intent")
+		}).
+		StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+			d.ConditionsDie(
+				dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.Status(metav1.ConditionUnknown),
+			)
+		})
+
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = conventionsv1alpha1.AddToScheme(scheme)
+
+	testServer, caCert, err := fake.NewFakeConventionServer()
+	if err != nil {
+		t.Fatalf("unable to create convention server: %v", err)
+	}
+	testServer.StartTLS()
+	defer testServer.Close()
+
+	serverURL, err := url.ParseRequestURI(testServer.URL)
+	if err != nil {
+		t.Fatalf("this should never happen? %v", err)
+	}
+
+	wc := binding.WebhookConfig{
+		AuthInfoResolver: webhooktesting.NewAuthenticationInfoResolver(new(int32)),
+		ServiceResolver:  fake.NewStubServiceResolver(*serverURL),
+	}
+	stashedValues := map[reconcilers.StashKey]interface{}{
+		controllers.RegistryConfigKey: binding.RegistryConfig{},
+		controllers.ConventionsStashKey: []binding.Convention{
+			{
+				Name:     testConventions,
+				Priority: conventionsv1alpha1.NormalPriority,
+				ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{
+						Namespace: "default",
+						Name:      "webhook-test",
+						Path:      pointer.String("panic"),
+					},
+					CABundle: caCert,
+				},
+			},
+		},
+	}
+	failed := workload.
+		StatusDie(func(d *dieconventionsv1alpha1.PodIntentStatusDie) {
+			d.ConditionsDie(
+				dieconventionsv1alpha1.PodIntentConditionConventionsAppliedBlank.
+					Status(metav1.ConditionFalse).
+					Reason("WebhookServerError").
+					Message("failed to apply convention with name my-conventions: ConventionPanic: convention panicked: assignment to entry in nil map"),
+				dieconventionsv1alpha1.PodIntentConditionReadyBlank.
+					Status(metav1.ConditionFalse).
+					Reason("WebhookServerError").
+					Message("failed to apply convention with name my-conventions: ConventionPanic: convention panicked: assignment to entry in nil map"),
+			)
+		})
+
+	// the same reconciler sees each step, as it would for retries of the PodIntent
+	r := controllers.ApplyConventionsReconciler(wc)
+	rts := rtesting.SubReconcilerSequence[*conventionsv1alpha1.PodIntent]{{
+		Name:               "first failure records events",
+		Resource:           workload.DieReleasePtr(),
+		GivenStashedValues: stashedValues,
+		ExpectedResult:     reconcile.Result{RequeueAfter: 10 * time.Second},
+		ExpectResource:     failed.DieReleasePtr(),
+		ExpectEvents: []rtesting.Event{
+			rtesting.NewEvent(workload, scheme, corev1.EventTypeWarning, "ConventionFailed", `Failed to apply convention my-conventions: failed to apply convention with name my-conventions: ConventionPanic: convention panicked: assignment to entry in nil map`),
+		},
+	}, {
+		Name:               "retried failure records no events",
+		Resource:           workload.DieReleasePtr(),
+		GivenStashedValues: stashedValues,
+		ExpectedResult:     reconcile.Result{RequeueAfter: 20 * time.Second},
+		ExpectResource:     failed.DieReleasePtr(),
+	}}
+
+	rts.Run(t, scheme, func(t *testing.T, rtc *rtesting.SubReconcilerTestCase[*conventionsv1alpha1.PodIntent], c reconcilers.Config) reconcilers.SubReconciler[*conventionsv1alpha1.PodIntent] {
+		return r
+	})
+}
+
 func TestStashConventions(t *testing.T) {
 	ctx := reconcilers.WithStash(context.TODO())
 	var expected, actual []binding.Convention
@@ -49,6 +49,35 @@ func (d *ClusterPodConventionSpecDie) WebookDie(fn func(d *ClusterPodConventionW
 	})
 }
 
+func (d *ClusterPodConventionSpecDie) MutationsDie(fn func(d *ClusterPodConventionMutationsDie)) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		d := ClusterPodConventionMutationsBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.Mutations)
+		fn(d)
+		r.Mutations = d.DieReleasePtr()
+	})
+}
+
+func (d *ClusterPodConventionSpecDie) ParamsDie(fn func(d *ClusterPodConventionParamsDie)) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		d := ClusterPodConventionParamsBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.Params)
+		fn(d)
+		r.Params = d.DieReleasePtr()
+	})
+}
+
+func (d *ClusterPodConventionSpecDie) ResourcesDie(resources ...*ClusterPodConventionResourceDie) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.Resources = make([]conventionsv1alpha1.ClusterPodConventionResource, len(resources))
+		for i := range resources {
+			r.Resources[i] = resources[i].DieRelease()
+		}
+	})
+}
+
 // +die
 type _ = conventionsv1alpha1.ClusterPodConventionWebhook
 
@@ -72,5 +101,100 @@ func (d *ClusterPodConventionWebhookDie) CertificateDie(fn func(d *ClusterPodCon
 	})
 }
 
+func (d *ClusterPodConventionWebhookDie) BOMsDie(fn func(d *ClusterPodConventionWebhookBOMsDie)) *ClusterPodConventionWebhookDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhook) {
+		d := ClusterPodConventionWebhookBOMsBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.BOMs)
+		fn(d)
+		r.BOMs = d.DieReleasePtr()
+	})
+}
+
+func (d *ClusterPodConventionWebhookDie) ServiceAccountTokenDie(fn func(d *ClusterPodConventionWebhookServiceAccountTokenDie)) *ClusterPodConventionWebhookDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhook) {
+		d := ClusterPodConventionWebhookServiceAccountTokenBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.ServiceAccountToken)
+		fn(d)
+		r.ServiceAccountToken = d.DieReleasePtr()
+	})
+}
+
 // +die
 type _ = conventionsv1alpha1.ClusterPodConventionWebhookCertificate
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionWebhookBOMs
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionMutations
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionParams
+
+func (d *ClusterPodConventionParamsDie) AddValue(key, value string) *ClusterPodConventionParamsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParams) {
+		if r.Values == nil {
+			r.Values = map[string]string{}
+		}
+		r.Values[key] = value
+	})
+}
+
+func (d *ClusterPodConventionParamsDie) FromDie(sources ...*ClusterPodConventionParamsSourceDie) *ClusterPodConventionParamsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParams) {
+		r.From = make([]conventionsv1alpha1.ClusterPodConventionParamsSource, len(sources))
+		for i := range sources {
+			r.From[i] = sources[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionParamsSource
+
+func (d *ClusterPodConventionParamsSourceDie) ConfigMapRefDie(fn func(d *ClusterPodConventionParamsReferenceDie)) *ClusterPodConventionParamsSourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParamsSource) {
+		d := ClusterPodConventionParamsReferenceBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.ConfigMapRef)
+		fn(d)
+		r.ConfigMapRef = d.DieReleasePtr()
+	})
+}
+
+func (d *ClusterPodConventionParamsSourceDie) SecretRefDie(fn func(d *ClusterPodConventionParamsReferenceDie)) *ClusterPodConventionParamsSourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParamsSource) {
+		d := ClusterPodConventionParamsReferenceBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.SecretRef)
+		fn(d)
+		r.SecretRef = d.DieReleasePtr()
+	})
+}
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionParamsReference
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionResource
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionStatus
+
+func (d *ClusterPodConventionStatusDie) CircuitBreakerDie(fn func(d *ClusterPodConventionCircuitBreakerStatusDie)) *ClusterPodConventionStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionStatus) {
+		d := ClusterPodConventionCircuitBreakerStatusBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.CircuitBreaker)
+		fn(d)
+		r.CircuitBreaker = d.DieReleasePtr()
+	})
+}
+
+// +die
+type _ = conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus
@@ -0,0 +1,36 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	conventionsv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/pkg/apis/conventions/v1alpha1"
+)
+
+// +die:object=true
+type _ = conventionsv1alpha1.ConventionProfile
+
+// +die
+type _ = conventionsv1alpha1.ConventionProfileSpec
+
+func (d *ConventionProfileSpecDie) AddParam(key, value string) *ConventionProfileSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfileSpec) {
+		if r.Params == nil {
+			r.Params = map[string]string{}
+		}
+		r.Params[key] = value
+	})
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"encoding/json"
+
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	diecorev1 "reconciler.io/dies/apis/core/v1"
 	diemetav1 "reconciler.io/dies/apis/meta/v1"
@@ -50,6 +53,19 @@ func (d *PodIntentSpecDie) TemplateDie(fn func(d *diecorev1.PodTemplateSpecDie))
 	})
 }
 
+func (d *PodIntentSpecDie) ConventionsDie(fn func(d *PodIntentConventionsDie)) *PodIntentSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
+		d := PodIntentConventionsBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.Conventions)
+		fn(d)
+		r.Conventions = d.DieReleasePtr()
+	})
+}
+
+// +die
+type _ = conventionsv1alpha1.PodIntentConventions
+
 // +die
 type _ = conventionsv1alpha1.PodIntentStatus
 
@@ -78,6 +94,73 @@ func (d *PodIntentStatusDie) TemplateDie(fn func(d *diecorev1.PodTemplateSpecDie
 	})
 }
 
+func (d *PodIntentStatusDie) WarningsDie(warnings ...*PodIntentWarningDie) *PodIntentStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
+		r.Warnings = make([]conventionsv1alpha1.PodIntentWarning, len(warnings))
+		for i := range warnings {
+			r.Warnings[i] = warnings[i].DieRelease()
+		}
+	})
+}
+
+func (d *PodIntentStatusDie) PreviewDie(fn func(d *PodIntentPreviewDie)) *PodIntentStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
+		d := PodIntentPreviewBlank.
+			DieImmutable(false).
+			DieFeedPtr(r.Preview)
+		fn(d)
+		r.Preview = d.DieReleasePtr()
+	})
+}
+
+// +die
+type _ = conventionsv1alpha1.PodIntentWarning
+
+// +die
+type _ = conventionsv1alpha1.PodIntentPreview
+
+func (d *PodIntentPreviewDie) TemplateDie(fn func(d *diecorev1.PodTemplateSpecDie)) *PodIntentPreviewDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreview) {
+		template, err := r.PodTemplate()
+		if err != nil {
+			panic(err)
+		}
+		d := diecorev1.PodTemplateSpecBlank.
+			DieImmutable(false).
+			DieFeedPtr(template.AsPodTemplateSpec())
+		fn(d)
+		raw, err := json.Marshal(conventionsv1alpha1.NewPodTemplateSpec(d.DieReleasePtr()))
+		if err != nil {
+			panic(err)
+		}
+		r.Template = &apiextensionsv1.JSON{Raw: raw}
+	})
+}
+
+func (d *PodIntentPreviewDie) ConventionsDie(conventions ...*PodIntentPreviewConventionDie) *PodIntentPreviewDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreview) {
+		r.Conventions = make([]conventionsv1alpha1.PodIntentPreviewConvention, len(conventions))
+		for i := range conventions {
+			r.Conventions[i] = conventions[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = conventionsv1alpha1.PodIntentPreviewConvention
+
+func (d *PodIntentPreviewConventionDie) ChangesDie(changes ...*PodIntentPreviewChangeDie) *PodIntentPreviewConventionDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreviewConvention) {
+		r.Changes = make([]conventionsv1alpha1.PodIntentPreviewChange, len(changes))
+		for i := range changes {
+			r.Changes[i] = changes[i].DieRelease()
+		}
+	})
+}
+
+// +die
+type _ = conventionsv1alpha1.PodIntentPreviewChange
+
 var (
 	PodIntentConditionReadyBlank              = diemetav1.ConditionBlank.Type(conventionsv1alpha1.PodIntentConditionReady)
 	PodIntentConditionConventionsAppliedBlank = diemetav1.ConditionBlank.Type(conventionsv1alpha1.PodIntentConditionConventionsApplied)
@@ -29,6 +29,7 @@ import (
 	cmp "github.com/google/go-cmp/cmp"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -386,12 +387,27 @@ func (d *ClusterPodConventionDie) SpecDie(fn func(d *ClusterPodConventionSpecDie
 	})
 }
 
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *ClusterPodConventionDie) StatusDie(fn func(d *ClusterPodConventionStatusDie)) *ClusterPodConventionDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConvention) {
+		d := ClusterPodConventionStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
 func (d *ClusterPodConventionDie) Spec(v conventionsv1alpha1.ClusterPodConventionSpec) *ClusterPodConventionDie {
 	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConvention) {
 		r.Spec = v
 	})
 }
 
+func (d *ClusterPodConventionDie) Status(v conventionsv1alpha1.ClusterPodConventionStatus) *ClusterPodConventionDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConvention) {
+		r.Status = v
+	})
+}
+
 var ClusterPodConventionSpecBlank = (&ClusterPodConventionSpecDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionSpec{})
 
 type ClusterPodConventionSpecDie struct {
@@ -665,6 +681,91 @@ func (d *ClusterPodConventionSpecDie) Webhook(v *conventionsv1alpha1.ClusterPodC
 	})
 }
 
+// Mode is either Mutate, to apply the convention to the template, or Validate, to allow or
+//
+// deny the template after all mutating conventions are applied. Defaults to Mutate.
+func (d *ClusterPodConventionSpecDie) Mode(v conventionsv1alpha1.ConventionMode) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.Mode = v
+	})
+}
+
+// Mutations restricts the changes the convention may make to the pod template. All changes
+//
+// are allowed when not specified.
+func (d *ClusterPodConventionSpecDie) Mutations(v *conventionsv1alpha1.ClusterPodConventionMutations) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.Mutations = v
+	})
+}
+
+// Params configure the convention, they are sent to the webhook with each request so one
+//
+// convention server can serve several differently configured conventions.
+func (d *ClusterPodConventionSpecDie) Params(v *conventionsv1alpha1.ClusterPodConventionParams) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.Params = v
+	})
+}
+
+// Resources are read by the controller from the PodIntent's namespace and sent to the
+//
+// convention with each request, so the convention server does not need access to the cluster.
+func (d *ClusterPodConventionSpecDie) Resources(v ...conventionsv1alpha1.ClusterPodConventionResource) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.Resources = v
+	})
+}
+
+// Order sorts conventions within the same priority, lower values are applied first.
+//
+// Conventions with the same order are applied alphabetically by name. Defaults to 0.
+func (d *ClusterPodConventionSpecDie) Order(v int32) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.Order = v
+	})
+}
+
+// RunAfter are the names of ClusterPodConventions this convention is applied after, when
+//
+// they apply to the same workload. References take precedence over priority and order.
+func (d *ClusterPodConventionSpecDie) RunAfter(v ...string) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.RunAfter = v
+	})
+}
+
+// RunBefore are the names of ClusterPodConventions this convention is applied before, when
+//
+// they apply to the same workload. References take precedence over priority and order.
+func (d *ClusterPodConventionSpecDie) RunBefore(v ...string) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.RunBefore = v
+	})
+}
+
+// ExclusiveFields are patterns for the fields of the pod template only this convention may
+//
+// write, like `.spec.containers[*].livenessProbe`. Applying conventions fails when another
+//
+// convention writes a field matched by the patterns, or a field containing it. Otherwise a
+//
+// convention overwriting a field written by an earlier convention is reported as a warning.
+func (d *ClusterPodConventionSpecDie) ExclusiveFields(v ...string) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.ExclusiveFields = v
+	})
+}
+
+// Mandatory conventions are applied to every workload their selectors match. PodIntents may
+//
+// not exclude mandatory conventions, or the conventions within them.
+func (d *ClusterPodConventionSpecDie) Mandatory(v bool) *ClusterPodConventionSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionSpec) {
+		r.Mandatory = v
+	})
+}
+
 var ClusterPodConventionWebhookBlank = (&ClusterPodConventionWebhookDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionWebhook{})
 
 type ClusterPodConventionWebhookDie struct {
@@ -925,6 +1026,26 @@ func (d *ClusterPodConventionWebhookDie) Certificate(v *conventionsv1alpha1.Clus
 	})
 }
 
+// BOMs filters the software bill of materials sent to the convention for each image.
+//
+// All BOMs are sent when not specified.
+func (d *ClusterPodConventionWebhookDie) BOMs(v *conventionsv1alpha1.ClusterPodConventionWebhookBOMs) *ClusterPodConventionWebhookDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhook) {
+		r.BOMs = v
+	})
+}
+
+// ServiceAccountToken sends a token for the controller's service account, scoped to an
+//
+// audience, as a bearer token with each request. The convention can authenticate the
+//
+// controller by reviewing the token.
+func (d *ClusterPodConventionWebhookDie) ServiceAccountToken(v *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken) *ClusterPodConventionWebhookDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhook) {
+		r.ServiceAccountToken = v
+	})
+}
+
 var ClusterPodConventionWebhookCertificateBlank = (&ClusterPodConventionWebhookCertificateDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionWebhookCertificate{})
 
 type ClusterPodConventionWebhookCertificateDie struct {
@@ -1183,17 +1304,16 @@ func (d *ClusterPodConventionWebhookCertificateDie) Name(v string) *ClusterPodCo
 	})
 }
 
-var PodIntentBlank = (&PodIntentDie{}).DieFeed(conventionsv1alpha1.PodIntent{})
+var ClusterPodConventionWebhookBOMsBlank = (&ClusterPodConventionWebhookBOMsDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionWebhookBOMs{})
 
-type PodIntentDie struct {
-	v1.FrozenObjectMeta
+type ClusterPodConventionWebhookBOMsDie struct {
 	mutable bool
-	r       conventionsv1alpha1.PodIntent
-	seal    conventionsv1alpha1.PodIntent
+	r       conventionsv1alpha1.ClusterPodConventionWebhookBOMs
+	seal    conventionsv1alpha1.ClusterPodConventionWebhookBOMs
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *PodIntentDie) DieImmutable(immutable bool) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieImmutable(immutable bool) *ClusterPodConventionWebhookBOMsDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1203,30 +1323,28 @@ func (d *PodIntentDie) DieImmutable(immutable bool) *PodIntentDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *PodIntentDie) DieFeed(r conventionsv1alpha1.PodIntent) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionWebhookBOMs) *ClusterPodConventionWebhookBOMsDie {
 	if d.mutable {
-		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
 		d.r = r
 		return d
 	}
-	return &PodIntentDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &ClusterPodConventionWebhookBOMsDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *PodIntentDie) DieFeedPtr(r *conventionsv1alpha1.PodIntent) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionWebhookBOMs) *ClusterPodConventionWebhookBOMsDie {
 	if r == nil {
-		r = &conventionsv1alpha1.PodIntent{}
+		r = &conventionsv1alpha1.ClusterPodConventionWebhookBOMs{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *PodIntentDie) DieFeedDuck(v any) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieFeedDuck(v any) *ClusterPodConventionWebhookBOMsDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1235,8 +1353,8 @@ func (d *PodIntentDie) DieFeedDuck(v any) *PodIntentDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *PodIntentDie) DieFeedJSON(j []byte) *PodIntentDie {
-	r := conventionsv1alpha1.PodIntent{}
+func (d *ClusterPodConventionWebhookBOMsDie) DieFeedJSON(j []byte) *ClusterPodConventionWebhookBOMsDie {
+	r := conventionsv1alpha1.ClusterPodConventionWebhookBOMs{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1244,8 +1362,8 @@ func (d *PodIntentDie) DieFeedJSON(j []byte) *PodIntentDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *PodIntentDie) DieFeedYAML(y []byte) *PodIntentDie {
-	r := conventionsv1alpha1.PodIntent{}
+func (d *ClusterPodConventionWebhookBOMsDie) DieFeedYAML(y []byte) *ClusterPodConventionWebhookBOMsDie {
+	r := conventionsv1alpha1.ClusterPodConventionWebhookBOMs{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1253,7 +1371,7 @@ func (d *PodIntentDie) DieFeedYAML(y []byte) *PodIntentDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *PodIntentDie) DieFeedYAMLFile(name string) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieFeedYAMLFile(name string) *ClusterPodConventionWebhookBOMsDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1262,7 +1380,7 @@ func (d *PodIntentDie) DieFeedYAMLFile(name string) *PodIntentDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *PodIntentDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionWebhookBOMsDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1271,7 +1389,7 @@ func (d *PodIntentDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentD
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *PodIntentDie) DieRelease() conventionsv1alpha1.PodIntent {
+func (d *ClusterPodConventionWebhookBOMsDie) DieRelease() conventionsv1alpha1.ClusterPodConventionWebhookBOMs {
 	if d.mutable {
 		return d.r
 	}
@@ -1279,25 +1397,13 @@ func (d *PodIntentDie) DieRelease() conventionsv1alpha1.PodIntent {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *PodIntentDie) DieReleasePtr() *conventionsv1alpha1.PodIntent {
+func (d *ClusterPodConventionWebhookBOMsDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionWebhookBOMs {
 	r := d.DieRelease()
 	return &r
 }
 
-// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
-func (d *PodIntentDie) DieReleaseUnstructured() *unstructured.Unstructured {
-	r := d.DieReleasePtr()
-	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
-	if err != nil {
-		panic(err)
-	}
-	return &unstructured.Unstructured{
-		Object: u,
-	}
-}
-
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *PodIntentDie) DieReleaseDuck(v any) any {
+func (d *ClusterPodConventionWebhookBOMsDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1306,7 +1412,7 @@ func (d *PodIntentDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *PodIntentDie) DieReleaseJSON() []byte {
+func (d *ClusterPodConventionWebhookBOMsDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1316,7 +1422,7 @@ func (d *PodIntentDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *PodIntentDie) DieReleaseYAML() []byte {
+func (d *ClusterPodConventionWebhookBOMsDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1326,7 +1432,7 @@ func (d *PodIntentDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *PodIntentDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterPodConventionWebhookBOMsDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1336,7 +1442,7 @@ func (d *PodIntentDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *PodIntentDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntent)) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionWebhookBOMs)) *ClusterPodConventionWebhookBOMsDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1345,8 +1451,8 @@ func (d *PodIntentDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntent)) *PodI
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *PodIntentDie) DieStampAt(jp string, fn interface{}) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+func (d *ClusterPodConventionWebhookBOMsDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionWebhookBOMsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhookBOMs) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1381,8 +1487,8 @@ func (d *PodIntentDie) DieStampAt(jp string, fn interface{}) *PodIntentDie {
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *PodIntentDie) DieWith(fns ...func(d *PodIntentDie)) *PodIntentDie {
-	nd := PodIntentBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterPodConventionWebhookBOMsDie) DieWith(fns ...func(d *ClusterPodConventionWebhookBOMsDie)) *ClusterPodConventionWebhookBOMsDie {
+	nd := ClusterPodConventionWebhookBOMsBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1392,23 +1498,22 @@ func (d *PodIntentDie) DieWith(fns ...func(d *PodIntentDie)) *PodIntentDie {
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *PodIntentDie) DeepCopy() *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DeepCopy() *ClusterPodConventionWebhookBOMsDie {
 	r := *d.r.DeepCopy()
-	return &PodIntentDie{
-		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
-		mutable:          d.mutable,
-		r:                r,
-		seal:             d.seal,
+	return &ClusterPodConventionWebhookBOMsDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
 	}
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *PodIntentDie) DieSeal() *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieSeal() *ClusterPodConventionWebhookBOMsDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *PodIntentDie) DieSealFeed(r conventionsv1alpha1.PodIntent) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionWebhookBOMs) *ClusterPodConventionWebhookBOMsDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1417,145 +1522,69 @@ func (d *PodIntentDie) DieSealFeed(r conventionsv1alpha1.PodIntent) *PodIntentDi
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *PodIntentDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntent) *PodIntentDie {
+func (d *ClusterPodConventionWebhookBOMsDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionWebhookBOMs) *ClusterPodConventionWebhookBOMsDie {
 	if r == nil {
-		r = &conventionsv1alpha1.PodIntent{}
+		r = &conventionsv1alpha1.ClusterPodConventionWebhookBOMs{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *PodIntentDie) DieSealRelease() conventionsv1alpha1.PodIntent {
+func (d *ClusterPodConventionWebhookBOMsDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionWebhookBOMs {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *PodIntentDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntent {
+func (d *ClusterPodConventionWebhookBOMsDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionWebhookBOMs {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *PodIntentDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterPodConventionWebhookBOMsDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *PodIntentDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterPodConventionWebhookBOMsDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-var _ runtime.Object = (*PodIntentDie)(nil)
-
-func (d *PodIntentDie) DeepCopyObject() runtime.Object {
-	return d.r.DeepCopy()
-}
-
-func (d *PodIntentDie) GetObjectKind() schema.ObjectKind {
-	r := d.DieRelease()
-	return r.GetObjectKind()
-}
-
-func (d *PodIntentDie) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d.r)
-}
-
-func (d *PodIntentDie) UnmarshalJSON(b []byte) error {
-	if !d.mutable {
-		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
-	}
-	resource := &conventionsv1alpha1.PodIntent{}
-	err := json.Unmarshal(b, resource)
-	*d = *d.DieFeed(*resource)
-	return err
-}
-
-// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
-func (d *PodIntentDie) APIVersion(v string) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		r.APIVersion = v
-	})
-}
-
-// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
-func (d *PodIntentDie) Kind(v string) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		r.Kind = v
-	})
-}
-
-// TypeMetadata standard object's type metadata.
-func (d *PodIntentDie) TypeMetadata(v metav1.TypeMeta) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		r.TypeMeta = v
-	})
-}
-
-// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
-func (d *PodIntentDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
-		fn(d)
-		r.TypeMeta = d.DieRelease()
-	})
-}
-
-// Metadata standard object's metadata.
-func (d *PodIntentDie) Metadata(v metav1.ObjectMeta) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		r.ObjectMeta = v
-	})
-}
-
-// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
-func (d *PodIntentDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
-		fn(d)
-		r.ObjectMeta = d.DieRelease()
-	})
-}
-
-// SpecDie stamps the resource's spec field with a mutable die.
-func (d *PodIntentDie) SpecDie(fn func(d *PodIntentSpecDie)) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		d := PodIntentSpecBlank.DieImmutable(false).DieFeed(r.Spec)
-		fn(d)
-		r.Spec = d.DieRelease()
-	})
-}
-
-// StatusDie stamps the resource's status field with a mutable die.
-func (d *PodIntentDie) StatusDie(fn func(d *PodIntentStatusDie)) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		d := PodIntentStatusBlank.DieImmutable(false).DieFeed(r.Status)
-		fn(d)
-		r.Status = d.DieRelease()
+// None excludes all BOMs from the request, for conventions that do not inspect BOMs.
+func (d *ClusterPodConventionWebhookBOMsDie) None(v bool) *ClusterPodConventionWebhookBOMsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhookBOMs) {
+		r.None = v
 	})
 }
 
-func (d *PodIntentDie) Spec(v conventionsv1alpha1.PodIntentSpec) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		r.Spec = v
+// Names are glob patterns matched against the BOM name, for example
+//
+// `cnb-app:/layers/sbom/launch/*/sbom.cdx.json`. As with path.Match, `*` does not match `/`.
+//
+// A BOM is sent if it matches any of the patterns.
+func (d *ClusterPodConventionWebhookBOMsDie) Names(v ...string) *ClusterPodConventionWebhookBOMsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhookBOMs) {
+		r.Names = v
 	})
 }
 
-func (d *PodIntentDie) Status(v conventionsv1alpha1.PodIntentStatus) *PodIntentDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
-		r.Status = v
+// Formats limits the BOMs to the formats listed, as detected from the BOM name.
+func (d *ClusterPodConventionWebhookBOMsDie) Formats(v ...conventionsv1alpha1.BOMFormat) *ClusterPodConventionWebhookBOMsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhookBOMs) {
+		r.Formats = v
 	})
 }
 
-var PodIntentSpecBlank = (&PodIntentSpecDie{}).DieFeed(conventionsv1alpha1.PodIntentSpec{})
+var ClusterPodConventionWebhookServiceAccountTokenBlank = (&ClusterPodConventionWebhookServiceAccountTokenDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken{})
 
-type PodIntentSpecDie struct {
+type ClusterPodConventionWebhookServiceAccountTokenDie struct {
 	mutable bool
-	r       conventionsv1alpha1.PodIntentSpec
-	seal    conventionsv1alpha1.PodIntentSpec
+	r       conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken
+	seal    conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *PodIntentSpecDie) DieImmutable(immutable bool) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieImmutable(immutable bool) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1565,12 +1594,12 @@ func (d *PodIntentSpecDie) DieImmutable(immutable bool) *PodIntentSpecDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *PodIntentSpecDie) DieFeed(r conventionsv1alpha1.PodIntentSpec) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &PodIntentSpecDie{
+	return &ClusterPodConventionWebhookServiceAccountTokenDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1578,15 +1607,15 @@ func (d *PodIntentSpecDie) DieFeed(r conventionsv1alpha1.PodIntentSpec) *PodInte
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *PodIntentSpecDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentSpec) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	if r == nil {
-		r = &conventionsv1alpha1.PodIntentSpec{}
+		r = &conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *PodIntentSpecDie) DieFeedDuck(v any) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieFeedDuck(v any) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1595,8 +1624,8 @@ func (d *PodIntentSpecDie) DieFeedDuck(v any) *PodIntentSpecDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *PodIntentSpecDie) DieFeedJSON(j []byte) *PodIntentSpecDie {
-	r := conventionsv1alpha1.PodIntentSpec{}
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieFeedJSON(j []byte) *ClusterPodConventionWebhookServiceAccountTokenDie {
+	r := conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1604,8 +1633,8 @@ func (d *PodIntentSpecDie) DieFeedJSON(j []byte) *PodIntentSpecDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *PodIntentSpecDie) DieFeedYAML(y []byte) *PodIntentSpecDie {
-	r := conventionsv1alpha1.PodIntentSpec{}
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieFeedYAML(y []byte) *ClusterPodConventionWebhookServiceAccountTokenDie {
+	r := conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1613,7 +1642,7 @@ func (d *PodIntentSpecDie) DieFeedYAML(y []byte) *PodIntentSpecDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *PodIntentSpecDie) DieFeedYAMLFile(name string) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieFeedYAMLFile(name string) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1622,7 +1651,7 @@ func (d *PodIntentSpecDie) DieFeedYAMLFile(name string) *PodIntentSpecDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *PodIntentSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1631,7 +1660,7 @@ func (d *PodIntentSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *PodInt
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *PodIntentSpecDie) DieRelease() conventionsv1alpha1.PodIntentSpec {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieRelease() conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken {
 	if d.mutable {
 		return d.r
 	}
@@ -1639,13 +1668,13 @@ func (d *PodIntentSpecDie) DieRelease() conventionsv1alpha1.PodIntentSpec {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *PodIntentSpecDie) DieReleasePtr() *conventionsv1alpha1.PodIntentSpec {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *PodIntentSpecDie) DieReleaseDuck(v any) any {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1654,7 +1683,7 @@ func (d *PodIntentSpecDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *PodIntentSpecDie) DieReleaseJSON() []byte {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1664,7 +1693,7 @@ func (d *PodIntentSpecDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *PodIntentSpecDie) DieReleaseYAML() []byte {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1674,7 +1703,7 @@ func (d *PodIntentSpecDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *PodIntentSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1684,7 +1713,7 @@ func (d *PodIntentSpecDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *PodIntentSpecDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentSpec)) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken)) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1693,8 +1722,8 @@ func (d *PodIntentSpecDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentSpec
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *PodIntentSpecDie) DieStampAt(jp string, fn interface{}) *PodIntentSpecDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionWebhookServiceAccountTokenDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -1729,8 +1758,8 @@ func (d *PodIntentSpecDie) DieStampAt(jp string, fn interface{}) *PodIntentSpecD
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *PodIntentSpecDie) DieWith(fns ...func(d *PodIntentSpecDie)) *PodIntentSpecDie {
-	nd := PodIntentSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieWith(fns ...func(d *ClusterPodConventionWebhookServiceAccountTokenDie)) *ClusterPodConventionWebhookServiceAccountTokenDie {
+	nd := ClusterPodConventionWebhookServiceAccountTokenBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -1740,9 +1769,9 @@ func (d *PodIntentSpecDie) DieWith(fns ...func(d *PodIntentSpecDie)) *PodIntentS
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *PodIntentSpecDie) DeepCopy() *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DeepCopy() *ClusterPodConventionWebhookServiceAccountTokenDie {
 	r := *d.r.DeepCopy()
-	return &PodIntentSpecDie{
+	return &ClusterPodConventionWebhookServiceAccountTokenDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1750,12 +1779,12 @@ func (d *PodIntentSpecDie) DeepCopy() *PodIntentSpecDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *PodIntentSpecDie) DieSeal() *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieSeal() *ClusterPodConventionWebhookServiceAccountTokenDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *PodIntentSpecDie) DieSealFeed(r conventionsv1alpha1.PodIntentSpec) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -1764,71 +1793,60 @@ func (d *PodIntentSpecDie) DieSealFeed(r conventionsv1alpha1.PodIntentSpec) *Pod
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *PodIntentSpecDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentSpec) *PodIntentSpecDie {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken) *ClusterPodConventionWebhookServiceAccountTokenDie {
 	if r == nil {
-		r = &conventionsv1alpha1.PodIntentSpec{}
+		r = &conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *PodIntentSpecDie) DieSealRelease() conventionsv1alpha1.PodIntentSpec {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *PodIntentSpecDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentSpec {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *PodIntentSpecDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *PodIntentSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-// ServiceAccountName is the name of the Kubernetes ServiceAccount used to authenticate
-//
-// the image pull if the service account has attached pull secrets. For more information:
-//
-// https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/#add-imagepullsecrets-to-a-service-account
-func (d *PodIntentSpecDie) ServiceAccountName(v string) *PodIntentSpecDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
-		r.ServiceAccountName = v
+// Audience the token is issued for. The convention must reject tokens for other audiences.
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) Audience(v string) *ClusterPodConventionWebhookServiceAccountTokenDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken) {
+		r.Audience = v
 	})
 }
 
-// ImagePullSecrets contains the names of the Kubernetes Secrets containing registry login
+// ExpirationSeconds is the requested lifetime of the token, at least ten minutes and one hour
 //
-// information to resolve image metadata.
-func (d *PodIntentSpecDie) ImagePullSecrets(v ...corev1.LocalObjectReference) *PodIntentSpecDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
-		r.ImagePullSecrets = v
-	})
-}
-
-// Template defines the workload pod temple
-func (d *PodIntentSpecDie) Template(v conventionsv1alpha1.PodTemplateSpec) *PodIntentSpecDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
-		r.Template = v
+// by default. Tokens are renewed before they expire.
+func (d *ClusterPodConventionWebhookServiceAccountTokenDie) ExpirationSeconds(v *int64) *ClusterPodConventionWebhookServiceAccountTokenDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionWebhookServiceAccountToken) {
+		r.ExpirationSeconds = v
 	})
 }
 
-var PodIntentStatusBlank = (&PodIntentStatusDie{}).DieFeed(conventionsv1alpha1.PodIntentStatus{})
+var ClusterPodConventionMutationsBlank = (&ClusterPodConventionMutationsDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionMutations{})
 
-type PodIntentStatusDie struct {
+type ClusterPodConventionMutationsDie struct {
 	mutable bool
-	r       conventionsv1alpha1.PodIntentStatus
-	seal    conventionsv1alpha1.PodIntentStatus
+	r       conventionsv1alpha1.ClusterPodConventionMutations
+	seal    conventionsv1alpha1.ClusterPodConventionMutations
 }
 
 // DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
-func (d *PodIntentStatusDie) DieImmutable(immutable bool) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieImmutable(immutable bool) *ClusterPodConventionMutationsDie {
 	if d.mutable == !immutable {
 		return d
 	}
@@ -1838,12 +1856,12 @@ func (d *PodIntentStatusDie) DieImmutable(immutable bool) *PodIntentStatusDie {
 }
 
 // DieFeed returns a new die with the provided resource.
-func (d *PodIntentStatusDie) DieFeed(r conventionsv1alpha1.PodIntentStatus) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionMutations) *ClusterPodConventionMutationsDie {
 	if d.mutable {
 		d.r = r
 		return d
 	}
-	return &PodIntentStatusDie{
+	return &ClusterPodConventionMutationsDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -1851,15 +1869,15 @@ func (d *PodIntentStatusDie) DieFeed(r conventionsv1alpha1.PodIntentStatus) *Pod
 }
 
 // DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
-func (d *PodIntentStatusDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentStatus) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionMutations) *ClusterPodConventionMutationsDie {
 	if r == nil {
-		r = &conventionsv1alpha1.PodIntentStatus{}
+		r = &conventionsv1alpha1.ClusterPodConventionMutations{}
 	}
 	return d.DieFeed(*r)
 }
 
 // DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
-func (d *PodIntentStatusDie) DieFeedDuck(v any) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieFeedDuck(v any) *ClusterPodConventionMutationsDie {
 	data, err := json.Marshal(v)
 	if err != nil {
 		panic(err)
@@ -1868,8 +1886,8 @@ func (d *PodIntentStatusDie) DieFeedDuck(v any) *PodIntentStatusDie {
 }
 
 // DieFeedJSON returns a new die with the provided JSON. Panics on error.
-func (d *PodIntentStatusDie) DieFeedJSON(j []byte) *PodIntentStatusDie {
-	r := conventionsv1alpha1.PodIntentStatus{}
+func (d *ClusterPodConventionMutationsDie) DieFeedJSON(j []byte) *ClusterPodConventionMutationsDie {
+	r := conventionsv1alpha1.ClusterPodConventionMutations{}
 	if err := json.Unmarshal(j, &r); err != nil {
 		panic(err)
 	}
@@ -1877,8 +1895,8 @@ func (d *PodIntentStatusDie) DieFeedJSON(j []byte) *PodIntentStatusDie {
 }
 
 // DieFeedYAML returns a new die with the provided YAML. Panics on error.
-func (d *PodIntentStatusDie) DieFeedYAML(y []byte) *PodIntentStatusDie {
-	r := conventionsv1alpha1.PodIntentStatus{}
+func (d *ClusterPodConventionMutationsDie) DieFeedYAML(y []byte) *ClusterPodConventionMutationsDie {
+	r := conventionsv1alpha1.ClusterPodConventionMutations{}
 	if err := yaml.Unmarshal(y, &r); err != nil {
 		panic(err)
 	}
@@ -1886,7 +1904,7 @@ func (d *PodIntentStatusDie) DieFeedYAML(y []byte) *PodIntentStatusDie {
 }
 
 // DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
-func (d *PodIntentStatusDie) DieFeedYAMLFile(name string) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieFeedYAMLFile(name string) *ClusterPodConventionMutationsDie {
 	y, err := osx.ReadFile(name)
 	if err != nil {
 		panic(err)
@@ -1895,7 +1913,7 @@ func (d *PodIntentStatusDie) DieFeedYAMLFile(name string) *PodIntentStatusDie {
 }
 
 // DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *PodIntentStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionMutationsDie {
 	j, err := json.Marshal(raw)
 	if err != nil {
 		panic(err)
@@ -1904,7 +1922,7 @@ func (d *PodIntentStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *PodI
 }
 
 // DieRelease returns the resource managed by the die.
-func (d *PodIntentStatusDie) DieRelease() conventionsv1alpha1.PodIntentStatus {
+func (d *ClusterPodConventionMutationsDie) DieRelease() conventionsv1alpha1.ClusterPodConventionMutations {
 	if d.mutable {
 		return d.r
 	}
@@ -1912,13 +1930,13 @@ func (d *PodIntentStatusDie) DieRelease() conventionsv1alpha1.PodIntentStatus {
 }
 
 // DieReleasePtr returns a pointer to the resource managed by the die.
-func (d *PodIntentStatusDie) DieReleasePtr() *conventionsv1alpha1.PodIntentStatus {
+func (d *ClusterPodConventionMutationsDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionMutations {
 	r := d.DieRelease()
 	return &r
 }
 
 // DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
-func (d *PodIntentStatusDie) DieReleaseDuck(v any) any {
+func (d *ClusterPodConventionMutationsDie) DieReleaseDuck(v any) any {
 	data := d.DieReleaseJSON()
 	if err := json.Unmarshal(data, v); err != nil {
 		panic(err)
@@ -1927,7 +1945,7 @@ func (d *PodIntentStatusDie) DieReleaseDuck(v any) any {
 }
 
 // DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
-func (d *PodIntentStatusDie) DieReleaseJSON() []byte {
+func (d *ClusterPodConventionMutationsDie) DieReleaseJSON() []byte {
 	r := d.DieReleasePtr()
 	j, err := json.Marshal(r)
 	if err != nil {
@@ -1937,7 +1955,7 @@ func (d *PodIntentStatusDie) DieReleaseJSON() []byte {
 }
 
 // DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
-func (d *PodIntentStatusDie) DieReleaseYAML() []byte {
+func (d *ClusterPodConventionMutationsDie) DieReleaseYAML() []byte {
 	r := d.DieReleasePtr()
 	y, err := yaml.Marshal(r)
 	if err != nil {
@@ -1947,7 +1965,7 @@ func (d *PodIntentStatusDie) DieReleaseYAML() []byte {
 }
 
 // DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
-func (d *PodIntentStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+func (d *ClusterPodConventionMutationsDie) DieReleaseRawExtension() runtime.RawExtension {
 	j := d.DieReleaseJSON()
 	raw := runtime.RawExtension{}
 	if err := json.Unmarshal(j, &raw); err != nil {
@@ -1957,7 +1975,7 @@ func (d *PodIntentStatusDie) DieReleaseRawExtension() runtime.RawExtension {
 }
 
 // DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
-func (d *PodIntentStatusDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentStatus)) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionMutations)) *ClusterPodConventionMutationsDie {
 	r := d.DieRelease()
 	fn(&r)
 	return d.DieFeed(r)
@@ -1966,8 +1984,8 @@ func (d *PodIntentStatusDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentSt
 // Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
 //
 // Future iterations will improve type coercion from the resource to the callback argument.
-func (d *PodIntentStatusDie) DieStampAt(jp string, fn interface{}) *PodIntentStatusDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
+func (d *ClusterPodConventionMutationsDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionMutationsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionMutations) {
 		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
 			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
 		}
@@ -2002,8 +2020,8 @@ func (d *PodIntentStatusDie) DieStampAt(jp string, fn interface{}) *PodIntentSta
 }
 
 // DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
-func (d *PodIntentStatusDie) DieWith(fns ...func(d *PodIntentStatusDie)) *PodIntentStatusDie {
-	nd := PodIntentStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+func (d *ClusterPodConventionMutationsDie) DieWith(fns ...func(d *ClusterPodConventionMutationsDie)) *ClusterPodConventionMutationsDie {
+	nd := ClusterPodConventionMutationsBlank.DieFeed(d.DieRelease()).DieImmutable(false)
 	for _, fn := range fns {
 		if fn != nil {
 			fn(nd)
@@ -2013,9 +2031,9 @@ func (d *PodIntentStatusDie) DieWith(fns ...func(d *PodIntentStatusDie)) *PodInt
 }
 
 // DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
-func (d *PodIntentStatusDie) DeepCopy() *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DeepCopy() *ClusterPodConventionMutationsDie {
 	r := *d.r.DeepCopy()
-	return &PodIntentStatusDie{
+	return &ClusterPodConventionMutationsDie{
 		mutable: d.mutable,
 		r:       r,
 		seal:    d.seal,
@@ -2023,12 +2041,12 @@ func (d *PodIntentStatusDie) DeepCopy() *PodIntentStatusDie {
 }
 
 // DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
-func (d *PodIntentStatusDie) DieSeal() *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieSeal() *ClusterPodConventionMutationsDie {
 	return d.DieSealFeed(d.r)
 }
 
 // DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
-func (d *PodIntentStatusDie) DieSealFeed(r conventionsv1alpha1.PodIntentStatus) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionMutations) *ClusterPodConventionMutationsDie {
 	if !d.mutable {
 		d = d.DeepCopy()
 	}
@@ -2037,42 +2055,4521 @@ func (d *PodIntentStatusDie) DieSealFeed(r conventionsv1alpha1.PodIntentStatus)
 }
 
 // DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
-func (d *PodIntentStatusDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentStatus) *PodIntentStatusDie {
+func (d *ClusterPodConventionMutationsDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionMutations) *ClusterPodConventionMutationsDie {
 	if r == nil {
-		r = &conventionsv1alpha1.PodIntentStatus{}
+		r = &conventionsv1alpha1.ClusterPodConventionMutations{}
 	}
 	return d.DieSealFeed(*r)
 }
 
 // DieSealRelease returns the sealed resource managed by the die.
-func (d *PodIntentStatusDie) DieSealRelease() conventionsv1alpha1.PodIntentStatus {
+func (d *ClusterPodConventionMutationsDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionMutations {
 	return *d.seal.DeepCopy()
 }
 
 // DieSealReleasePtr returns the sealed resource pointer managed by the die.
-func (d *PodIntentStatusDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentStatus {
+func (d *ClusterPodConventionMutationsDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionMutations {
 	r := d.DieSealRelease()
 	return &r
 }
 
 // DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
-func (d *PodIntentStatusDie) DieDiff(opts ...cmp.Option) string {
+func (d *ClusterPodConventionMutationsDie) DieDiff(opts ...cmp.Option) string {
 	return cmp.Diff(d.seal, d.r, opts...)
 }
 
 // DiePatch generates a patch between the current value of the die and the sealed value.
-func (d *PodIntentStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+func (d *ClusterPodConventionMutationsDie) DiePatch(patchType types.PatchType) ([]byte, error) {
 	return patch.Create(d.seal, d.r, patchType)
 }
 
-func (d *PodIntentStatusDie) Status(v apis.Status) *PodIntentStatusDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
-		r.Status = v
+// AllowedScopes are the parts of the pod template the convention may change. Containers may
+//
+// not be added or removed, and their images may not be changed, by a convention with
+//
+// allowed scopes.
+func (d *ClusterPodConventionMutationsDie) AllowedScopes(v ...conventionsv1alpha1.MutationScope) *ClusterPodConventionMutationsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionMutations) {
+		r.AllowedScopes = v
 	})
 }
 
-func (d *PodIntentStatusDie) Template(v *conventionsv1alpha1.PodTemplateSpec) *PodIntentStatusDie {
-	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
-		r.Template = v
+// Policy for changes outside of the allowed scopes, either Fail or Strip. Defaults to Fail.
+func (d *ClusterPodConventionMutationsDie) Policy(v conventionsv1alpha1.MutationPolicy) *ClusterPodConventionMutationsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionMutations) {
+		r.Policy = v
+	})
+}
+
+var ClusterPodConventionParamsBlank = (&ClusterPodConventionParamsDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionParams{})
+
+type ClusterPodConventionParamsDie struct {
+	mutable bool
+	r       conventionsv1alpha1.ClusterPodConventionParams
+	seal    conventionsv1alpha1.ClusterPodConventionParams
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterPodConventionParamsDie) DieImmutable(immutable bool) *ClusterPodConventionParamsDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterPodConventionParamsDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionParams) *ClusterPodConventionParamsDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterPodConventionParamsDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionParamsDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionParams) *ClusterPodConventionParamsDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionParams{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieFeedDuck(v any) *ClusterPodConventionParamsDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieFeedJSON(j []byte) *ClusterPodConventionParamsDie {
+	r := conventionsv1alpha1.ClusterPodConventionParams{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieFeedYAML(y []byte) *ClusterPodConventionParamsDie {
+	r := conventionsv1alpha1.ClusterPodConventionParams{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieFeedYAMLFile(name string) *ClusterPodConventionParamsDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionParamsDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterPodConventionParamsDie) DieRelease() conventionsv1alpha1.ClusterPodConventionParams {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterPodConventionParamsDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionParams {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionParamsDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterPodConventionParamsDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionParams)) *ClusterPodConventionParamsDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterPodConventionParamsDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionParamsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParams) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterPodConventionParamsDie) DieWith(fns ...func(d *ClusterPodConventionParamsDie)) *ClusterPodConventionParamsDie {
+	nd := ClusterPodConventionParamsBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterPodConventionParamsDie) DeepCopy() *ClusterPodConventionParamsDie {
+	r := *d.r.DeepCopy()
+	return &ClusterPodConventionParamsDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterPodConventionParamsDie) DieSeal() *ClusterPodConventionParamsDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterPodConventionParamsDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionParams) *ClusterPodConventionParamsDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionParamsDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionParams) *ClusterPodConventionParamsDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionParams{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterPodConventionParamsDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionParams {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterPodConventionParamsDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionParams {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterPodConventionParamsDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterPodConventionParamsDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Values are free-form parameters for the convention. Values take precedence over params
+//
+// read from ConfigMaps and Secrets.
+func (d *ClusterPodConventionParamsDie) Values(v map[string]string) *ClusterPodConventionParamsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParams) {
+		r.Values = v
+	})
+}
+
+// From are ConfigMaps and Secrets whose data is read as params. Later sources take
+//
+// precedence over earlier sources.
+func (d *ClusterPodConventionParamsDie) From(v ...conventionsv1alpha1.ClusterPodConventionParamsSource) *ClusterPodConventionParamsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParams) {
+		r.From = v
+	})
+}
+
+// NamespaceOverrides is the name of a ConfigMap in the PodIntent's namespace whose data
+//
+// overrides the params for workloads in that namespace. The ConfigMap is optional.
+func (d *ClusterPodConventionParamsDie) NamespaceOverrides(v string) *ClusterPodConventionParamsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParams) {
+		r.NamespaceOverrides = v
+	})
+}
+
+var ClusterPodConventionParamsSourceBlank = (&ClusterPodConventionParamsSourceDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionParamsSource{})
+
+type ClusterPodConventionParamsSourceDie struct {
+	mutable bool
+	r       conventionsv1alpha1.ClusterPodConventionParamsSource
+	seal    conventionsv1alpha1.ClusterPodConventionParamsSource
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterPodConventionParamsSourceDie) DieImmutable(immutable bool) *ClusterPodConventionParamsSourceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterPodConventionParamsSourceDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionParamsSource) *ClusterPodConventionParamsSourceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterPodConventionParamsSourceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionParamsSourceDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionParamsSource) *ClusterPodConventionParamsSourceDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionParamsSource{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieFeedDuck(v any) *ClusterPodConventionParamsSourceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieFeedJSON(j []byte) *ClusterPodConventionParamsSourceDie {
+	r := conventionsv1alpha1.ClusterPodConventionParamsSource{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieFeedYAML(y []byte) *ClusterPodConventionParamsSourceDie {
+	r := conventionsv1alpha1.ClusterPodConventionParamsSource{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieFeedYAMLFile(name string) *ClusterPodConventionParamsSourceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionParamsSourceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterPodConventionParamsSourceDie) DieRelease() conventionsv1alpha1.ClusterPodConventionParamsSource {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterPodConventionParamsSourceDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionParamsSource {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionParamsSourceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterPodConventionParamsSourceDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionParamsSource)) *ClusterPodConventionParamsSourceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterPodConventionParamsSourceDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionParamsSourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParamsSource) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterPodConventionParamsSourceDie) DieWith(fns ...func(d *ClusterPodConventionParamsSourceDie)) *ClusterPodConventionParamsSourceDie {
+	nd := ClusterPodConventionParamsSourceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterPodConventionParamsSourceDie) DeepCopy() *ClusterPodConventionParamsSourceDie {
+	r := *d.r.DeepCopy()
+	return &ClusterPodConventionParamsSourceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterPodConventionParamsSourceDie) DieSeal() *ClusterPodConventionParamsSourceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterPodConventionParamsSourceDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionParamsSource) *ClusterPodConventionParamsSourceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionParamsSourceDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionParamsSource) *ClusterPodConventionParamsSourceDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionParamsSource{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterPodConventionParamsSourceDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionParamsSource {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterPodConventionParamsSourceDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionParamsSource {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterPodConventionParamsSourceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterPodConventionParamsSourceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// ConfigMapRef reads params from the data of a ConfigMap.
+func (d *ClusterPodConventionParamsSourceDie) ConfigMapRef(v *conventionsv1alpha1.ClusterPodConventionParamsReference) *ClusterPodConventionParamsSourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParamsSource) {
+		r.ConfigMapRef = v
+	})
+}
+
+// SecretRef reads params from the data of a Secret.
+func (d *ClusterPodConventionParamsSourceDie) SecretRef(v *conventionsv1alpha1.ClusterPodConventionParamsReference) *ClusterPodConventionParamsSourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParamsSource) {
+		r.SecretRef = v
+	})
+}
+
+var ClusterPodConventionParamsReferenceBlank = (&ClusterPodConventionParamsReferenceDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionParamsReference{})
+
+type ClusterPodConventionParamsReferenceDie struct {
+	mutable bool
+	r       conventionsv1alpha1.ClusterPodConventionParamsReference
+	seal    conventionsv1alpha1.ClusterPodConventionParamsReference
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterPodConventionParamsReferenceDie) DieImmutable(immutable bool) *ClusterPodConventionParamsReferenceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterPodConventionParamsReferenceDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionParamsReference) *ClusterPodConventionParamsReferenceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterPodConventionParamsReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionParamsReferenceDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionParamsReference) *ClusterPodConventionParamsReferenceDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionParamsReference{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieFeedDuck(v any) *ClusterPodConventionParamsReferenceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieFeedJSON(j []byte) *ClusterPodConventionParamsReferenceDie {
+	r := conventionsv1alpha1.ClusterPodConventionParamsReference{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieFeedYAML(y []byte) *ClusterPodConventionParamsReferenceDie {
+	r := conventionsv1alpha1.ClusterPodConventionParamsReference{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieFeedYAMLFile(name string) *ClusterPodConventionParamsReferenceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionParamsReferenceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterPodConventionParamsReferenceDie) DieRelease() conventionsv1alpha1.ClusterPodConventionParamsReference {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterPodConventionParamsReferenceDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionParamsReference {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionParamsReferenceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterPodConventionParamsReferenceDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionParamsReference)) *ClusterPodConventionParamsReferenceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterPodConventionParamsReferenceDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionParamsReferenceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParamsReference) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterPodConventionParamsReferenceDie) DieWith(fns ...func(d *ClusterPodConventionParamsReferenceDie)) *ClusterPodConventionParamsReferenceDie {
+	nd := ClusterPodConventionParamsReferenceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterPodConventionParamsReferenceDie) DeepCopy() *ClusterPodConventionParamsReferenceDie {
+	r := *d.r.DeepCopy()
+	return &ClusterPodConventionParamsReferenceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterPodConventionParamsReferenceDie) DieSeal() *ClusterPodConventionParamsReferenceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterPodConventionParamsReferenceDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionParamsReference) *ClusterPodConventionParamsReferenceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionParamsReferenceDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionParamsReference) *ClusterPodConventionParamsReferenceDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionParamsReference{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterPodConventionParamsReferenceDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionParamsReference {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterPodConventionParamsReferenceDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionParamsReference {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterPodConventionParamsReferenceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterPodConventionParamsReferenceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+func (d *ClusterPodConventionParamsReferenceDie) Namespace(v string) *ClusterPodConventionParamsReferenceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParamsReference) {
+		r.Namespace = v
+	})
+}
+
+func (d *ClusterPodConventionParamsReferenceDie) Name(v string) *ClusterPodConventionParamsReferenceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionParamsReference) {
+		r.Name = v
+	})
+}
+
+var ClusterPodConventionResourceBlank = (&ClusterPodConventionResourceDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionResource{})
+
+type ClusterPodConventionResourceDie struct {
+	mutable bool
+	r       conventionsv1alpha1.ClusterPodConventionResource
+	seal    conventionsv1alpha1.ClusterPodConventionResource
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterPodConventionResourceDie) DieImmutable(immutable bool) *ClusterPodConventionResourceDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterPodConventionResourceDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionResource) *ClusterPodConventionResourceDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterPodConventionResourceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionResourceDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionResource) *ClusterPodConventionResourceDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionResource{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieFeedDuck(v any) *ClusterPodConventionResourceDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieFeedJSON(j []byte) *ClusterPodConventionResourceDie {
+	r := conventionsv1alpha1.ClusterPodConventionResource{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieFeedYAML(y []byte) *ClusterPodConventionResourceDie {
+	r := conventionsv1alpha1.ClusterPodConventionResource{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieFeedYAMLFile(name string) *ClusterPodConventionResourceDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionResourceDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterPodConventionResourceDie) DieRelease() conventionsv1alpha1.ClusterPodConventionResource {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterPodConventionResourceDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionResource {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionResourceDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterPodConventionResourceDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionResource)) *ClusterPodConventionResourceDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterPodConventionResourceDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionResourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionResource) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterPodConventionResourceDie) DieWith(fns ...func(d *ClusterPodConventionResourceDie)) *ClusterPodConventionResourceDie {
+	nd := ClusterPodConventionResourceBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterPodConventionResourceDie) DeepCopy() *ClusterPodConventionResourceDie {
+	r := *d.r.DeepCopy()
+	return &ClusterPodConventionResourceDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterPodConventionResourceDie) DieSeal() *ClusterPodConventionResourceDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterPodConventionResourceDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionResource) *ClusterPodConventionResourceDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionResourceDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionResource) *ClusterPodConventionResourceDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionResource{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterPodConventionResourceDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionResource {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterPodConventionResourceDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionResource {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterPodConventionResourceDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterPodConventionResourceDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// APIVersion of the resources, must be `v1`.
+func (d *ClusterPodConventionResourceDie) APIVersion(v string) *ClusterPodConventionResourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionResource) {
+		r.APIVersion = v
+	})
+}
+
+// Kind of the resources, one of `ConfigMap`, `LimitRange` or `Secret`.
+func (d *ClusterPodConventionResourceDie) Kind(v string) *ClusterPodConventionResourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionResource) {
+		r.Kind = v
+	})
+}
+
+// Name is a glob pattern matched against the names of the resources in the PodIntent's
+//
+// namespace, for example `team-defaults` or `*`.
+func (d *ClusterPodConventionResourceDie) Name(v string) *ClusterPodConventionResourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionResource) {
+		r.Name = v
+	})
+}
+
+// MetadataOnly sends only the metadata of the resources. Secrets are always sent as
+//
+// metadata only.
+func (d *ClusterPodConventionResourceDie) MetadataOnly(v bool) *ClusterPodConventionResourceDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionResource) {
+		r.MetadataOnly = v
+	})
+}
+
+var ClusterPodConventionStatusBlank = (&ClusterPodConventionStatusDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionStatus{})
+
+type ClusterPodConventionStatusDie struct {
+	mutable bool
+	r       conventionsv1alpha1.ClusterPodConventionStatus
+	seal    conventionsv1alpha1.ClusterPodConventionStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterPodConventionStatusDie) DieImmutable(immutable bool) *ClusterPodConventionStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterPodConventionStatusDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionStatus) *ClusterPodConventionStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterPodConventionStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionStatusDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionStatus) *ClusterPodConventionStatusDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieFeedDuck(v any) *ClusterPodConventionStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieFeedJSON(j []byte) *ClusterPodConventionStatusDie {
+	r := conventionsv1alpha1.ClusterPodConventionStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieFeedYAML(y []byte) *ClusterPodConventionStatusDie {
+	r := conventionsv1alpha1.ClusterPodConventionStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieFeedYAMLFile(name string) *ClusterPodConventionStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterPodConventionStatusDie) DieRelease() conventionsv1alpha1.ClusterPodConventionStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterPodConventionStatusDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterPodConventionStatusDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionStatus)) *ClusterPodConventionStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterPodConventionStatusDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterPodConventionStatusDie) DieWith(fns ...func(d *ClusterPodConventionStatusDie)) *ClusterPodConventionStatusDie {
+	nd := ClusterPodConventionStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterPodConventionStatusDie) DeepCopy() *ClusterPodConventionStatusDie {
+	r := *d.r.DeepCopy()
+	return &ClusterPodConventionStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterPodConventionStatusDie) DieSeal() *ClusterPodConventionStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterPodConventionStatusDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionStatus) *ClusterPodConventionStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionStatusDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionStatus) *ClusterPodConventionStatusDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterPodConventionStatusDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterPodConventionStatusDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterPodConventionStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterPodConventionStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// CircuitBreaker is the state of the circuit breaker for requests to the convention's webhook.
+func (d *ClusterPodConventionStatusDie) CircuitBreaker(v *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) *ClusterPodConventionStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionStatus) {
+		r.CircuitBreaker = v
+	})
+}
+
+var ClusterPodConventionCircuitBreakerStatusBlank = (&ClusterPodConventionCircuitBreakerStatusDie{}).DieFeed(conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus{})
+
+type ClusterPodConventionCircuitBreakerStatusDie struct {
+	mutable bool
+	r       conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus
+	seal    conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieImmutable(immutable bool) *ClusterPodConventionCircuitBreakerStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieFeed(r conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) *ClusterPodConventionCircuitBreakerStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ClusterPodConventionCircuitBreakerStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieFeedPtr(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) *ClusterPodConventionCircuitBreakerStatusDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieFeedDuck(v any) *ClusterPodConventionCircuitBreakerStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieFeedJSON(j []byte) *ClusterPodConventionCircuitBreakerStatusDie {
+	r := conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieFeedYAML(y []byte) *ClusterPodConventionCircuitBreakerStatusDie {
+	r := conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieFeedYAMLFile(name string) *ClusterPodConventionCircuitBreakerStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *ClusterPodConventionCircuitBreakerStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieRelease() conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieReleasePtr() *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieStamp(fn func(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus)) *ClusterPodConventionCircuitBreakerStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieStampAt(jp string, fn interface{}) *ClusterPodConventionCircuitBreakerStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieWith(fns ...func(d *ClusterPodConventionCircuitBreakerStatusDie)) *ClusterPodConventionCircuitBreakerStatusDie {
+	nd := ClusterPodConventionCircuitBreakerStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DeepCopy() *ClusterPodConventionCircuitBreakerStatusDie {
+	r := *d.r.DeepCopy()
+	return &ClusterPodConventionCircuitBreakerStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieSeal() *ClusterPodConventionCircuitBreakerStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieSealFeed(r conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) *ClusterPodConventionCircuitBreakerStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieSealFeedPtr(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) *ClusterPodConventionCircuitBreakerStatusDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieSealRelease() conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieSealReleasePtr() *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// State of the circuit breaker, one of Closed, Open or HalfOpen.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) State(v conventionsv1alpha1.CircuitBreakerState) *ClusterPodConventionCircuitBreakerStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) {
+		r.State = v
+	})
+}
+
+// ConsecutiveFailures is the number of requests to the convention that failed in a row.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) ConsecutiveFailures(v int32) *ClusterPodConventionCircuitBreakerStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) {
+		r.ConsecutiveFailures = v
+	})
+}
+
+// LastTransitionTime is the last time the state changed.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) LastTransitionTime(v *metav1.Time) *ClusterPodConventionCircuitBreakerStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) {
+		r.LastTransitionTime = v
+	})
+}
+
+// RetryTime is when an open circuit allows a trial request to the convention, or when a
+//
+// half-open circuit allows another trial if the outcome of the current trial is unknown.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) RetryTime(v *metav1.Time) *ClusterPodConventionCircuitBreakerStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) {
+		r.RetryTime = v
+	})
+}
+
+// Message is the error of the last failed request.
+func (d *ClusterPodConventionCircuitBreakerStatusDie) Message(v string) *ClusterPodConventionCircuitBreakerStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ClusterPodConventionCircuitBreakerStatus) {
+		r.Message = v
+	})
+}
+
+var ConventionProfileBlank = (&ConventionProfileDie{}).DieFeed(conventionsv1alpha1.ConventionProfile{})
+
+type ConventionProfileDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       conventionsv1alpha1.ConventionProfile
+	seal    conventionsv1alpha1.ConventionProfile
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ConventionProfileDie) DieImmutable(immutable bool) *ConventionProfileDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ConventionProfileDie) DieFeed(r conventionsv1alpha1.ConventionProfile) *ConventionProfileDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &ConventionProfileDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ConventionProfileDie) DieFeedPtr(r *conventionsv1alpha1.ConventionProfile) *ConventionProfileDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ConventionProfile{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ConventionProfileDie) DieFeedDuck(v any) *ConventionProfileDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ConventionProfileDie) DieFeedJSON(j []byte) *ConventionProfileDie {
+	r := conventionsv1alpha1.ConventionProfile{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ConventionProfileDie) DieFeedYAML(y []byte) *ConventionProfileDie {
+	r := conventionsv1alpha1.ConventionProfile{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ConventionProfileDie) DieFeedYAMLFile(name string) *ConventionProfileDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ConventionProfileDie) DieFeedRawExtension(raw runtime.RawExtension) *ConventionProfileDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ConventionProfileDie) DieRelease() conventionsv1alpha1.ConventionProfile {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ConventionProfileDie) DieReleasePtr() *conventionsv1alpha1.ConventionProfile {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *ConventionProfileDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ConventionProfileDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ConventionProfileDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ConventionProfileDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ConventionProfileDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ConventionProfileDie) DieStamp(fn func(r *conventionsv1alpha1.ConventionProfile)) *ConventionProfileDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ConventionProfileDie) DieStampAt(jp string, fn interface{}) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ConventionProfileDie) DieWith(fns ...func(d *ConventionProfileDie)) *ConventionProfileDie {
+	nd := ConventionProfileBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ConventionProfileDie) DeepCopy() *ConventionProfileDie {
+	r := *d.r.DeepCopy()
+	return &ConventionProfileDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ConventionProfileDie) DieSeal() *ConventionProfileDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ConventionProfileDie) DieSealFeed(r conventionsv1alpha1.ConventionProfile) *ConventionProfileDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ConventionProfileDie) DieSealFeedPtr(r *conventionsv1alpha1.ConventionProfile) *ConventionProfileDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ConventionProfile{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ConventionProfileDie) DieSealRelease() conventionsv1alpha1.ConventionProfile {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ConventionProfileDie) DieSealReleasePtr() *conventionsv1alpha1.ConventionProfile {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ConventionProfileDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ConventionProfileDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*ConventionProfileDie)(nil)
+
+func (d *ConventionProfileDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *ConventionProfileDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *ConventionProfileDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *ConventionProfileDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &conventionsv1alpha1.ConventionProfile{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *ConventionProfileDie) APIVersion(v string) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *ConventionProfileDie) Kind(v string) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *ConventionProfileDie) TypeMetadata(v metav1.TypeMeta) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *ConventionProfileDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *ConventionProfileDie) Metadata(v metav1.ObjectMeta) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *ConventionProfileDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *ConventionProfileDie) SpecDie(fn func(d *ConventionProfileSpecDie)) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		d := ConventionProfileSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+func (d *ConventionProfileDie) Spec(v conventionsv1alpha1.ConventionProfileSpec) *ConventionProfileDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfile) {
+		r.Spec = v
+	})
+}
+
+var ConventionProfileSpecBlank = (&ConventionProfileSpecDie{}).DieFeed(conventionsv1alpha1.ConventionProfileSpec{})
+
+type ConventionProfileSpecDie struct {
+	mutable bool
+	r       conventionsv1alpha1.ConventionProfileSpec
+	seal    conventionsv1alpha1.ConventionProfileSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *ConventionProfileSpecDie) DieImmutable(immutable bool) *ConventionProfileSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *ConventionProfileSpecDie) DieFeed(r conventionsv1alpha1.ConventionProfileSpec) *ConventionProfileSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &ConventionProfileSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *ConventionProfileSpecDie) DieFeedPtr(r *conventionsv1alpha1.ConventionProfileSpec) *ConventionProfileSpecDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ConventionProfileSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *ConventionProfileSpecDie) DieFeedDuck(v any) *ConventionProfileSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *ConventionProfileSpecDie) DieFeedJSON(j []byte) *ConventionProfileSpecDie {
+	r := conventionsv1alpha1.ConventionProfileSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *ConventionProfileSpecDie) DieFeedYAML(y []byte) *ConventionProfileSpecDie {
+	r := conventionsv1alpha1.ConventionProfileSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *ConventionProfileSpecDie) DieFeedYAMLFile(name string) *ConventionProfileSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ConventionProfileSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *ConventionProfileSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *ConventionProfileSpecDie) DieRelease() conventionsv1alpha1.ConventionProfileSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *ConventionProfileSpecDie) DieReleasePtr() *conventionsv1alpha1.ConventionProfileSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *ConventionProfileSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *ConventionProfileSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *ConventionProfileSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *ConventionProfileSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *ConventionProfileSpecDie) DieStamp(fn func(r *conventionsv1alpha1.ConventionProfileSpec)) *ConventionProfileSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *ConventionProfileSpecDie) DieStampAt(jp string, fn interface{}) *ConventionProfileSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfileSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *ConventionProfileSpecDie) DieWith(fns ...func(d *ConventionProfileSpecDie)) *ConventionProfileSpecDie {
+	nd := ConventionProfileSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *ConventionProfileSpecDie) DeepCopy() *ConventionProfileSpecDie {
+	r := *d.r.DeepCopy()
+	return &ConventionProfileSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *ConventionProfileSpecDie) DieSeal() *ConventionProfileSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *ConventionProfileSpecDie) DieSealFeed(r conventionsv1alpha1.ConventionProfileSpec) *ConventionProfileSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *ConventionProfileSpecDie) DieSealFeedPtr(r *conventionsv1alpha1.ConventionProfileSpec) *ConventionProfileSpecDie {
+	if r == nil {
+		r = &conventionsv1alpha1.ConventionProfileSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *ConventionProfileSpecDie) DieSealRelease() conventionsv1alpha1.ConventionProfileSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *ConventionProfileSpecDie) DieSealReleasePtr() *conventionsv1alpha1.ConventionProfileSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *ConventionProfileSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *ConventionProfileSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Conventions are the names of the ClusterPodConventions in the profile, in the order they
+//
+// are applied. The conventions are applied to workloads selecting the profile regardless of
+//
+// their selectors.
+func (d *ConventionProfileSpecDie) Conventions(v ...string) *ConventionProfileSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfileSpec) {
+		r.Conventions = v
+	})
+}
+
+// Params are shared by the conventions in the profile. They take precedence over the params
+//
+// of each convention, other than the overrides from the PodIntent's namespace.
+func (d *ConventionProfileSpecDie) Params(v map[string]string) *ConventionProfileSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.ConventionProfileSpec) {
+		r.Params = v
+	})
+}
+
+var PodIntentBlank = (&PodIntentDie{}).DieFeed(conventionsv1alpha1.PodIntent{})
+
+type PodIntentDie struct {
+	v1.FrozenObjectMeta
+	mutable bool
+	r       conventionsv1alpha1.PodIntent
+	seal    conventionsv1alpha1.PodIntent
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *PodIntentDie) DieImmutable(immutable bool) *PodIntentDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *PodIntentDie) DieFeed(r conventionsv1alpha1.PodIntent) *PodIntentDie {
+	if d.mutable {
+		d.FrozenObjectMeta = v1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &PodIntentDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *PodIntentDie) DieFeedPtr(r *conventionsv1alpha1.PodIntent) *PodIntentDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntent{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *PodIntentDie) DieFeedDuck(v any) *PodIntentDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *PodIntentDie) DieFeedJSON(j []byte) *PodIntentDie {
+	r := conventionsv1alpha1.PodIntent{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *PodIntentDie) DieFeedYAML(y []byte) *PodIntentDie {
+	r := conventionsv1alpha1.PodIntent{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *PodIntentDie) DieFeedYAMLFile(name string) *PodIntentDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *PodIntentDie) DieRelease() conventionsv1alpha1.PodIntent {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *PodIntentDie) DieReleasePtr() *conventionsv1alpha1.PodIntent {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object. Panics on error.
+func (d *PodIntentDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *PodIntentDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *PodIntentDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *PodIntentDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *PodIntentDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntent)) *PodIntentDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *PodIntentDie) DieStampAt(jp string, fn interface{}) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *PodIntentDie) DieWith(fns ...func(d *PodIntentDie)) *PodIntentDie {
+	nd := PodIntentBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *PodIntentDie) DeepCopy() *PodIntentDie {
+	r := *d.r.DeepCopy()
+	return &PodIntentDie{
+		FrozenObjectMeta: v1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+		seal:             d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *PodIntentDie) DieSeal() *PodIntentDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *PodIntentDie) DieSealFeed(r conventionsv1alpha1.PodIntent) *PodIntentDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *PodIntentDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntent) *PodIntentDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntent{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *PodIntentDie) DieSealRelease() conventionsv1alpha1.PodIntent {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *PodIntentDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntent {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *PodIntentDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *PodIntentDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+var _ runtime.Object = (*PodIntentDie)(nil)
+
+func (d *PodIntentDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *PodIntentDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *PodIntentDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *PodIntentDie) UnmarshalJSON(b []byte) error {
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	resource := &conventionsv1alpha1.PodIntent{}
+	err := json.Unmarshal(b, resource)
+	*d = *d.DieFeed(*resource)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *PodIntentDie) APIVersion(v string) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *PodIntentDie) Kind(v string) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		r.Kind = v
+	})
+}
+
+// TypeMetadata standard object's type metadata.
+func (d *PodIntentDie) TypeMetadata(v metav1.TypeMeta) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		r.TypeMeta = v
+	})
+}
+
+// TypeMetadataDie stamps the resource's TypeMeta field with a mutable die.
+func (d *PodIntentDie) TypeMetadataDie(fn func(d *v1.TypeMetaDie)) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		d := v1.TypeMetaBlank.DieImmutable(false).DieFeed(r.TypeMeta)
+		fn(d)
+		r.TypeMeta = d.DieRelease()
+	})
+}
+
+// Metadata standard object's metadata.
+func (d *PodIntentDie) Metadata(v metav1.ObjectMeta) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		r.ObjectMeta = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *PodIntentDie) MetadataDie(fn func(d *v1.ObjectMetaDie)) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		d := v1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// SpecDie stamps the resource's spec field with a mutable die.
+func (d *PodIntentDie) SpecDie(fn func(d *PodIntentSpecDie)) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		d := PodIntentSpecBlank.DieImmutable(false).DieFeed(r.Spec)
+		fn(d)
+		r.Spec = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *PodIntentDie) StatusDie(fn func(d *PodIntentStatusDie)) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		d := PodIntentStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *PodIntentDie) Spec(v conventionsv1alpha1.PodIntentSpec) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		r.Spec = v
+	})
+}
+
+func (d *PodIntentDie) Status(v conventionsv1alpha1.PodIntentStatus) *PodIntentDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntent) {
+		r.Status = v
+	})
+}
+
+var PodIntentSpecBlank = (&PodIntentSpecDie{}).DieFeed(conventionsv1alpha1.PodIntentSpec{})
+
+type PodIntentSpecDie struct {
+	mutable bool
+	r       conventionsv1alpha1.PodIntentSpec
+	seal    conventionsv1alpha1.PodIntentSpec
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *PodIntentSpecDie) DieImmutable(immutable bool) *PodIntentSpecDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *PodIntentSpecDie) DieFeed(r conventionsv1alpha1.PodIntentSpec) *PodIntentSpecDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &PodIntentSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *PodIntentSpecDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentSpec) *PodIntentSpecDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentSpec{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *PodIntentSpecDie) DieFeedDuck(v any) *PodIntentSpecDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *PodIntentSpecDie) DieFeedJSON(j []byte) *PodIntentSpecDie {
+	r := conventionsv1alpha1.PodIntentSpec{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *PodIntentSpecDie) DieFeedYAML(y []byte) *PodIntentSpecDie {
+	r := conventionsv1alpha1.PodIntentSpec{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *PodIntentSpecDie) DieFeedYAMLFile(name string) *PodIntentSpecDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentSpecDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentSpecDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *PodIntentSpecDie) DieRelease() conventionsv1alpha1.PodIntentSpec {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *PodIntentSpecDie) DieReleasePtr() *conventionsv1alpha1.PodIntentSpec {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *PodIntentSpecDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *PodIntentSpecDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *PodIntentSpecDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentSpecDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *PodIntentSpecDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentSpec)) *PodIntentSpecDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *PodIntentSpecDie) DieStampAt(jp string, fn interface{}) *PodIntentSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *PodIntentSpecDie) DieWith(fns ...func(d *PodIntentSpecDie)) *PodIntentSpecDie {
+	nd := PodIntentSpecBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *PodIntentSpecDie) DeepCopy() *PodIntentSpecDie {
+	r := *d.r.DeepCopy()
+	return &PodIntentSpecDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *PodIntentSpecDie) DieSeal() *PodIntentSpecDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *PodIntentSpecDie) DieSealFeed(r conventionsv1alpha1.PodIntentSpec) *PodIntentSpecDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *PodIntentSpecDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentSpec) *PodIntentSpecDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentSpec{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *PodIntentSpecDie) DieSealRelease() conventionsv1alpha1.PodIntentSpec {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *PodIntentSpecDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentSpec {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *PodIntentSpecDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *PodIntentSpecDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// ServiceAccountName is the name of the Kubernetes ServiceAccount used to authenticate
+//
+// the image pull if the service account has attached pull secrets. For more information:
+//
+// https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/#add-imagepullsecrets-to-a-service-account
+func (d *PodIntentSpecDie) ServiceAccountName(v string) *PodIntentSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
+		r.ServiceAccountName = v
+	})
+}
+
+// ImagePullSecrets contains the names of the Kubernetes Secrets containing registry login
+//
+// information to resolve image metadata.
+func (d *PodIntentSpecDie) ImagePullSecrets(v ...corev1.LocalObjectReference) *PodIntentSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
+		r.ImagePullSecrets = v
+	})
+}
+
+// Template defines the workload pod temple
+func (d *PodIntentSpecDie) Template(v conventionsv1alpha1.PodTemplateSpec) *PodIntentSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
+		r.Template = v
+	})
+}
+
+// Conventions narrows the ClusterPodConventions applied to the workload, beyond their
+//
+// selectors. Mandatory conventions are always applied when their selectors match.
+func (d *PodIntentSpecDie) Conventions(v *conventionsv1alpha1.PodIntentConventions) *PodIntentSpecDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentSpec) {
+		r.Conventions = v
+	})
+}
+
+var PodIntentStatusBlank = (&PodIntentStatusDie{}).DieFeed(conventionsv1alpha1.PodIntentStatus{})
+
+type PodIntentStatusDie struct {
+	mutable bool
+	r       conventionsv1alpha1.PodIntentStatus
+	seal    conventionsv1alpha1.PodIntentStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *PodIntentStatusDie) DieImmutable(immutable bool) *PodIntentStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *PodIntentStatusDie) DieFeed(r conventionsv1alpha1.PodIntentStatus) *PodIntentStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &PodIntentStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *PodIntentStatusDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentStatus) *PodIntentStatusDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *PodIntentStatusDie) DieFeedDuck(v any) *PodIntentStatusDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *PodIntentStatusDie) DieFeedJSON(j []byte) *PodIntentStatusDie {
+	r := conventionsv1alpha1.PodIntentStatus{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *PodIntentStatusDie) DieFeedYAML(y []byte) *PodIntentStatusDie {
+	r := conventionsv1alpha1.PodIntentStatus{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *PodIntentStatusDie) DieFeedYAMLFile(name string) *PodIntentStatusDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentStatusDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *PodIntentStatusDie) DieRelease() conventionsv1alpha1.PodIntentStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *PodIntentStatusDie) DieReleasePtr() *conventionsv1alpha1.PodIntentStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *PodIntentStatusDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *PodIntentStatusDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *PodIntentStatusDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *PodIntentStatusDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentStatus)) *PodIntentStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *PodIntentStatusDie) DieStampAt(jp string, fn interface{}) *PodIntentStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *PodIntentStatusDie) DieWith(fns ...func(d *PodIntentStatusDie)) *PodIntentStatusDie {
+	nd := PodIntentStatusBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *PodIntentStatusDie) DeepCopy() *PodIntentStatusDie {
+	r := *d.r.DeepCopy()
+	return &PodIntentStatusDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *PodIntentStatusDie) DieSeal() *PodIntentStatusDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *PodIntentStatusDie) DieSealFeed(r conventionsv1alpha1.PodIntentStatus) *PodIntentStatusDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *PodIntentStatusDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentStatus) *PodIntentStatusDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentStatus{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *PodIntentStatusDie) DieSealRelease() conventionsv1alpha1.PodIntentStatus {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *PodIntentStatusDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentStatus {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *PodIntentStatusDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *PodIntentStatusDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+func (d *PodIntentStatusDie) Status(v apis.Status) *PodIntentStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
+		r.Status = v
+	})
+}
+
+// Template is the pod template resulting from the conventions, published when the Ready
+//
+// condition is True. The template is not cleared while the condition is False or Unknown,
+//
+// consumers must check the condition before reading it.
+func (d *PodIntentStatusDie) Template(v *conventionsv1alpha1.PodTemplateSpec) *PodIntentStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
+		r.Template = v
+	})
+}
+
+// Warnings reported by conventions the last time they were applied. Warnings do not
+//
+// prevent conventions from being applied.
+func (d *PodIntentStatusDie) Warnings(v ...conventionsv1alpha1.PodIntentWarning) *PodIntentStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
+		r.Warnings = v
+	})
+}
+
+// Preview of the conventions applied to the PodIntent while it is annotated for a dry run.
+//
+// The preview is not published, consumers should only read the status' template.
+func (d *PodIntentStatusDie) Preview(v *conventionsv1alpha1.PodIntentPreview) *PodIntentStatusDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentStatus) {
+		r.Preview = v
+	})
+}
+
+var PodIntentWarningBlank = (&PodIntentWarningDie{}).DieFeed(conventionsv1alpha1.PodIntentWarning{})
+
+type PodIntentWarningDie struct {
+	mutable bool
+	r       conventionsv1alpha1.PodIntentWarning
+	seal    conventionsv1alpha1.PodIntentWarning
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *PodIntentWarningDie) DieImmutable(immutable bool) *PodIntentWarningDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *PodIntentWarningDie) DieFeed(r conventionsv1alpha1.PodIntentWarning) *PodIntentWarningDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &PodIntentWarningDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *PodIntentWarningDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentWarning) *PodIntentWarningDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentWarning{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *PodIntentWarningDie) DieFeedDuck(v any) *PodIntentWarningDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *PodIntentWarningDie) DieFeedJSON(j []byte) *PodIntentWarningDie {
+	r := conventionsv1alpha1.PodIntentWarning{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *PodIntentWarningDie) DieFeedYAML(y []byte) *PodIntentWarningDie {
+	r := conventionsv1alpha1.PodIntentWarning{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *PodIntentWarningDie) DieFeedYAMLFile(name string) *PodIntentWarningDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentWarningDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentWarningDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *PodIntentWarningDie) DieRelease() conventionsv1alpha1.PodIntentWarning {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *PodIntentWarningDie) DieReleasePtr() *conventionsv1alpha1.PodIntentWarning {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *PodIntentWarningDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *PodIntentWarningDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *PodIntentWarningDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentWarningDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *PodIntentWarningDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentWarning)) *PodIntentWarningDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *PodIntentWarningDie) DieStampAt(jp string, fn interface{}) *PodIntentWarningDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentWarning) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *PodIntentWarningDie) DieWith(fns ...func(d *PodIntentWarningDie)) *PodIntentWarningDie {
+	nd := PodIntentWarningBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *PodIntentWarningDie) DeepCopy() *PodIntentWarningDie {
+	r := *d.r.DeepCopy()
+	return &PodIntentWarningDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *PodIntentWarningDie) DieSeal() *PodIntentWarningDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *PodIntentWarningDie) DieSealFeed(r conventionsv1alpha1.PodIntentWarning) *PodIntentWarningDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *PodIntentWarningDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentWarning) *PodIntentWarningDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentWarning{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *PodIntentWarningDie) DieSealRelease() conventionsv1alpha1.PodIntentWarning {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *PodIntentWarningDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentWarning {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *PodIntentWarningDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *PodIntentWarningDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Convention is the name of the ClusterPodConvention that reported the warning.
+func (d *PodIntentWarningDie) Convention(v string) *PodIntentWarningDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentWarning) {
+		r.Convention = v
+	})
+}
+
+// Message is the warning, for the developer of the workload.
+func (d *PodIntentWarningDie) Message(v string) *PodIntentWarningDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentWarning) {
+		r.Message = v
+	})
+}
+
+var PodIntentConventionsBlank = (&PodIntentConventionsDie{}).DieFeed(conventionsv1alpha1.PodIntentConventions{})
+
+type PodIntentConventionsDie struct {
+	mutable bool
+	r       conventionsv1alpha1.PodIntentConventions
+	seal    conventionsv1alpha1.PodIntentConventions
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *PodIntentConventionsDie) DieImmutable(immutable bool) *PodIntentConventionsDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *PodIntentConventionsDie) DieFeed(r conventionsv1alpha1.PodIntentConventions) *PodIntentConventionsDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &PodIntentConventionsDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *PodIntentConventionsDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentConventions) *PodIntentConventionsDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentConventions{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *PodIntentConventionsDie) DieFeedDuck(v any) *PodIntentConventionsDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *PodIntentConventionsDie) DieFeedJSON(j []byte) *PodIntentConventionsDie {
+	r := conventionsv1alpha1.PodIntentConventions{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *PodIntentConventionsDie) DieFeedYAML(y []byte) *PodIntentConventionsDie {
+	r := conventionsv1alpha1.PodIntentConventions{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *PodIntentConventionsDie) DieFeedYAMLFile(name string) *PodIntentConventionsDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentConventionsDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentConventionsDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *PodIntentConventionsDie) DieRelease() conventionsv1alpha1.PodIntentConventions {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *PodIntentConventionsDie) DieReleasePtr() *conventionsv1alpha1.PodIntentConventions {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *PodIntentConventionsDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *PodIntentConventionsDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *PodIntentConventionsDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentConventionsDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *PodIntentConventionsDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentConventions)) *PodIntentConventionsDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *PodIntentConventionsDie) DieStampAt(jp string, fn interface{}) *PodIntentConventionsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentConventions) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *PodIntentConventionsDie) DieWith(fns ...func(d *PodIntentConventionsDie)) *PodIntentConventionsDie {
+	nd := PodIntentConventionsBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *PodIntentConventionsDie) DeepCopy() *PodIntentConventionsDie {
+	r := *d.r.DeepCopy()
+	return &PodIntentConventionsDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *PodIntentConventionsDie) DieSeal() *PodIntentConventionsDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *PodIntentConventionsDie) DieSealFeed(r conventionsv1alpha1.PodIntentConventions) *PodIntentConventionsDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *PodIntentConventionsDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentConventions) *PodIntentConventionsDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentConventions{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *PodIntentConventionsDie) DieSealRelease() conventionsv1alpha1.PodIntentConventions {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *PodIntentConventionsDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentConventions {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *PodIntentConventionsDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *PodIntentConventionsDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Include, when not empty, are the names of the only ClusterPodConventions applied to the
+//
+// workload, along with mandatory conventions.
+func (d *PodIntentConventionsDie) Include(v ...string) *PodIntentConventionsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentConventions) {
+		r.Include = v
+	})
+}
+
+// Exclude are the names of ClusterPodConventions not applied to the workload, or of
+//
+// conventions within a ClusterPodConvention as `<cluster-pod-convention>/<convention>`, which
+//
+// are sent to the ClusterPodConvention to skip.
+func (d *PodIntentConventionsDie) Exclude(v ...string) *PodIntentConventionsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentConventions) {
+		r.Exclude = v
+	})
+}
+
+// Profile is the name of the ConventionProfile applied to the workload, in place of the
+//
+// profile selected by the PodIntent's namespace.
+func (d *PodIntentConventionsDie) Profile(v string) *PodIntentConventionsDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentConventions) {
+		r.Profile = v
+	})
+}
+
+var PodIntentPreviewBlank = (&PodIntentPreviewDie{}).DieFeed(conventionsv1alpha1.PodIntentPreview{})
+
+type PodIntentPreviewDie struct {
+	mutable bool
+	r       conventionsv1alpha1.PodIntentPreview
+	seal    conventionsv1alpha1.PodIntentPreview
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *PodIntentPreviewDie) DieImmutable(immutable bool) *PodIntentPreviewDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *PodIntentPreviewDie) DieFeed(r conventionsv1alpha1.PodIntentPreview) *PodIntentPreviewDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &PodIntentPreviewDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *PodIntentPreviewDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentPreview) *PodIntentPreviewDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentPreview{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *PodIntentPreviewDie) DieFeedDuck(v any) *PodIntentPreviewDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *PodIntentPreviewDie) DieFeedJSON(j []byte) *PodIntentPreviewDie {
+	r := conventionsv1alpha1.PodIntentPreview{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *PodIntentPreviewDie) DieFeedYAML(y []byte) *PodIntentPreviewDie {
+	r := conventionsv1alpha1.PodIntentPreview{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *PodIntentPreviewDie) DieFeedYAMLFile(name string) *PodIntentPreviewDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentPreviewDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentPreviewDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *PodIntentPreviewDie) DieRelease() conventionsv1alpha1.PodIntentPreview {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *PodIntentPreviewDie) DieReleasePtr() *conventionsv1alpha1.PodIntentPreview {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *PodIntentPreviewDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *PodIntentPreviewDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *PodIntentPreviewDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentPreviewDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *PodIntentPreviewDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentPreview)) *PodIntentPreviewDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *PodIntentPreviewDie) DieStampAt(jp string, fn interface{}) *PodIntentPreviewDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreview) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *PodIntentPreviewDie) DieWith(fns ...func(d *PodIntentPreviewDie)) *PodIntentPreviewDie {
+	nd := PodIntentPreviewBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *PodIntentPreviewDie) DeepCopy() *PodIntentPreviewDie {
+	r := *d.r.DeepCopy()
+	return &PodIntentPreviewDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *PodIntentPreviewDie) DieSeal() *PodIntentPreviewDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *PodIntentPreviewDie) DieSealFeed(r conventionsv1alpha1.PodIntentPreview) *PodIntentPreviewDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *PodIntentPreviewDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentPreview) *PodIntentPreviewDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentPreview{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *PodIntentPreviewDie) DieSealRelease() conventionsv1alpha1.PodIntentPreview {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *PodIntentPreviewDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentPreview {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *PodIntentPreviewDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *PodIntentPreviewDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Template is the pod template resulting from the conventions. The template is not validated,
+//
+// so the PodIntent's schema does not repeat the PodTemplateSpec, read it with PodTemplate.
+func (d *PodIntentPreviewDie) Template(v *apiextensionsv1.JSON) *PodIntentPreviewDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreview) {
+		r.Template = v
+	})
+}
+
+// Conventions are the changes made by each convention, in the order they were applied.
+func (d *PodIntentPreviewDie) Conventions(v ...conventionsv1alpha1.PodIntentPreviewConvention) *PodIntentPreviewDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreview) {
+		r.Conventions = v
+	})
+}
+
+var PodIntentPreviewConventionBlank = (&PodIntentPreviewConventionDie{}).DieFeed(conventionsv1alpha1.PodIntentPreviewConvention{})
+
+type PodIntentPreviewConventionDie struct {
+	mutable bool
+	r       conventionsv1alpha1.PodIntentPreviewConvention
+	seal    conventionsv1alpha1.PodIntentPreviewConvention
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *PodIntentPreviewConventionDie) DieImmutable(immutable bool) *PodIntentPreviewConventionDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *PodIntentPreviewConventionDie) DieFeed(r conventionsv1alpha1.PodIntentPreviewConvention) *PodIntentPreviewConventionDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &PodIntentPreviewConventionDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *PodIntentPreviewConventionDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentPreviewConvention) *PodIntentPreviewConventionDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentPreviewConvention{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieFeedDuck(v any) *PodIntentPreviewConventionDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieFeedJSON(j []byte) *PodIntentPreviewConventionDie {
+	r := conventionsv1alpha1.PodIntentPreviewConvention{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieFeedYAML(y []byte) *PodIntentPreviewConventionDie {
+	r := conventionsv1alpha1.PodIntentPreviewConvention{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieFeedYAMLFile(name string) *PodIntentPreviewConventionDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentPreviewConventionDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *PodIntentPreviewConventionDie) DieRelease() conventionsv1alpha1.PodIntentPreviewConvention {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *PodIntentPreviewConventionDie) DieReleasePtr() *conventionsv1alpha1.PodIntentPreviewConvention {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentPreviewConventionDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *PodIntentPreviewConventionDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentPreviewConvention)) *PodIntentPreviewConventionDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *PodIntentPreviewConventionDie) DieStampAt(jp string, fn interface{}) *PodIntentPreviewConventionDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreviewConvention) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *PodIntentPreviewConventionDie) DieWith(fns ...func(d *PodIntentPreviewConventionDie)) *PodIntentPreviewConventionDie {
+	nd := PodIntentPreviewConventionBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *PodIntentPreviewConventionDie) DeepCopy() *PodIntentPreviewConventionDie {
+	r := *d.r.DeepCopy()
+	return &PodIntentPreviewConventionDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *PodIntentPreviewConventionDie) DieSeal() *PodIntentPreviewConventionDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *PodIntentPreviewConventionDie) DieSealFeed(r conventionsv1alpha1.PodIntentPreviewConvention) *PodIntentPreviewConventionDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *PodIntentPreviewConventionDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentPreviewConvention) *PodIntentPreviewConventionDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentPreviewConvention{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *PodIntentPreviewConventionDie) DieSealRelease() conventionsv1alpha1.PodIntentPreviewConvention {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *PodIntentPreviewConventionDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentPreviewConvention {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *PodIntentPreviewConventionDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *PodIntentPreviewConventionDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Name of the ClusterPodConvention.
+func (d *PodIntentPreviewConventionDie) Name(v string) *PodIntentPreviewConventionDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreviewConvention) {
+		r.Name = v
+	})
+}
+
+// Changes are the fields of the pod template changed by the convention.
+func (d *PodIntentPreviewConventionDie) Changes(v ...conventionsv1alpha1.PodIntentPreviewChange) *PodIntentPreviewConventionDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreviewConvention) {
+		r.Changes = v
+	})
+}
+
+var PodIntentPreviewChangeBlank = (&PodIntentPreviewChangeDie{}).DieFeed(conventionsv1alpha1.PodIntentPreviewChange{})
+
+type PodIntentPreviewChangeDie struct {
+	mutable bool
+	r       conventionsv1alpha1.PodIntentPreviewChange
+	seal    conventionsv1alpha1.PodIntentPreviewChange
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *PodIntentPreviewChangeDie) DieImmutable(immutable bool) *PodIntentPreviewChangeDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *PodIntentPreviewChangeDie) DieFeed(r conventionsv1alpha1.PodIntentPreviewChange) *PodIntentPreviewChangeDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &PodIntentPreviewChangeDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *PodIntentPreviewChangeDie) DieFeedPtr(r *conventionsv1alpha1.PodIntentPreviewChange) *PodIntentPreviewChangeDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentPreviewChange{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedDuck returns a new die with the provided value converted into the underlying type. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieFeedDuck(v any) *PodIntentPreviewChangeDie {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(data)
+}
+
+// DieFeedJSON returns a new die with the provided JSON. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieFeedJSON(j []byte) *PodIntentPreviewChangeDie {
+	r := conventionsv1alpha1.PodIntentPreviewChange{}
+	if err := json.Unmarshal(j, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAML returns a new die with the provided YAML. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieFeedYAML(y []byte) *PodIntentPreviewChangeDie {
+	r := conventionsv1alpha1.PodIntentPreviewChange{}
+	if err := yaml.Unmarshal(y, &r); err != nil {
+		panic(err)
+	}
+	return d.DieFeed(r)
+}
+
+// DieFeedYAMLFile returns a new die loading YAML from a file path. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieFeedYAMLFile(name string) *PodIntentPreviewChangeDie {
+	y, err := osx.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedYAML(y)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieFeedRawExtension(raw runtime.RawExtension) *PodIntentPreviewChangeDie {
+	j, err := json.Marshal(raw)
+	if err != nil {
+		panic(err)
+	}
+	return d.DieFeedJSON(j)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *PodIntentPreviewChangeDie) DieRelease() conventionsv1alpha1.PodIntentPreviewChange {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *PodIntentPreviewChangeDie) DieReleasePtr() *conventionsv1alpha1.PodIntentPreviewChange {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseDuck releases the value into the passed value and returns the same. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieReleaseDuck(v any) any {
+	data := d.DieReleaseJSON()
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// DieReleaseJSON returns the resource managed by the die as JSON. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieReleaseJSON() []byte {
+	r := d.DieReleasePtr()
+	j, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return j
+}
+
+// DieReleaseYAML returns the resource managed by the die as YAML. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieReleaseYAML() []byte {
+	r := d.DieReleasePtr()
+	y, err := yaml.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	return y
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension. Panics on error.
+func (d *PodIntentPreviewChangeDie) DieReleaseRawExtension() runtime.RawExtension {
+	j := d.DieReleaseJSON()
+	raw := runtime.RawExtension{}
+	if err := json.Unmarshal(j, &raw); err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *PodIntentPreviewChangeDie) DieStamp(fn func(r *conventionsv1alpha1.PodIntentPreviewChange)) *PodIntentPreviewChangeDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// Experimental: DieStampAt uses a JSON path (http://goessner.net/articles/JsonPath/) expression to stamp portions of the resource. The callback is invoked with each JSON path match. Panics if the callback function does not accept a single argument of the same type or a pointer to that type as found on the resource at the target location.
+//
+// Future iterations will improve type coercion from the resource to the callback argument.
+func (d *PodIntentPreviewChangeDie) DieStampAt(jp string, fn interface{}) *PodIntentPreviewChangeDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreviewChange) {
+		if ni := reflectx.ValueOf(fn).Type().NumIn(); ni != 1 {
+			panic(fmtx.Errorf("callback function must have 1 input parameters, found %d", ni))
+		}
+		if no := reflectx.ValueOf(fn).Type().NumOut(); no != 0 {
+			panic(fmtx.Errorf("callback function must have 0 output parameters, found %d", no))
+		}
+
+		cp := jsonpath.New("")
+		if err := cp.Parse(fmtx.Sprintf("{%s}", jp)); err != nil {
+			panic(err)
+		}
+		cr, err := cp.FindResults(r)
+		if err != nil {
+			// errors are expected if a path is not found
+			return
+		}
+		for _, cv := range cr[0] {
+			arg0t := reflectx.ValueOf(fn).Type().In(0)
+
+			var args []reflectx.Value
+			if cv.Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv}
+			} else if cv.CanAddr() && cv.Addr().Type().AssignableTo(arg0t) {
+				args = []reflectx.Value{cv.Addr()}
+			} else {
+				panic(fmtx.Errorf("callback function must accept value of type %q, found type %q", cv.Type(), arg0t))
+			}
+
+			reflectx.ValueOf(fn).Call(args)
+		}
+	})
+}
+
+// DieWith returns a new die after passing the current die to the callback function. The passed die is mutable.
+func (d *PodIntentPreviewChangeDie) DieWith(fns ...func(d *PodIntentPreviewChangeDie)) *PodIntentPreviewChangeDie {
+	nd := PodIntentPreviewChangeBlank.DieFeed(d.DieRelease()).DieImmutable(false)
+	for _, fn := range fns {
+		if fn != nil {
+			fn(nd)
+		}
+	}
+	return d.DieFeed(nd.DieRelease())
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *PodIntentPreviewChangeDie) DeepCopy() *PodIntentPreviewChangeDie {
+	r := *d.r.DeepCopy()
+	return &PodIntentPreviewChangeDie{
+		mutable: d.mutable,
+		r:       r,
+		seal:    d.seal,
+	}
+}
+
+// DieSeal returns a new die for the current die's state that is sealed for comparison in future diff and patch operations.
+func (d *PodIntentPreviewChangeDie) DieSeal() *PodIntentPreviewChangeDie {
+	return d.DieSealFeed(d.r)
+}
+
+// DieSealFeed returns a new die for the current die's state that uses a specific resource for comparison in future diff and patch operations.
+func (d *PodIntentPreviewChangeDie) DieSealFeed(r conventionsv1alpha1.PodIntentPreviewChange) *PodIntentPreviewChangeDie {
+	if !d.mutable {
+		d = d.DeepCopy()
+	}
+	d.seal = *r.DeepCopy()
+	return d
+}
+
+// DieSealFeedPtr returns a new die for the current die's state that uses a specific resource pointer for comparison in future diff and patch operations. If the resource is nil, the empty value is used instead.
+func (d *PodIntentPreviewChangeDie) DieSealFeedPtr(r *conventionsv1alpha1.PodIntentPreviewChange) *PodIntentPreviewChangeDie {
+	if r == nil {
+		r = &conventionsv1alpha1.PodIntentPreviewChange{}
+	}
+	return d.DieSealFeed(*r)
+}
+
+// DieSealRelease returns the sealed resource managed by the die.
+func (d *PodIntentPreviewChangeDie) DieSealRelease() conventionsv1alpha1.PodIntentPreviewChange {
+	return *d.seal.DeepCopy()
+}
+
+// DieSealReleasePtr returns the sealed resource pointer managed by the die.
+func (d *PodIntentPreviewChangeDie) DieSealReleasePtr() *conventionsv1alpha1.PodIntentPreviewChange {
+	r := d.DieSealRelease()
+	return &r
+}
+
+// DieDiff uses cmp.Diff to compare the current value of the die with the sealed value.
+func (d *PodIntentPreviewChangeDie) DieDiff(opts ...cmp.Option) string {
+	return cmp.Diff(d.seal, d.r, opts...)
+}
+
+// DiePatch generates a patch between the current value of the die and the sealed value.
+func (d *PodIntentPreviewChangeDie) DiePatch(patchType types.PatchType) ([]byte, error) {
+	return patch.Create(d.seal, d.r, patchType)
+}
+
+// Field of the pod template, like `.spec.containers[workload].env`.
+func (d *PodIntentPreviewChangeDie) Field(v string) *PodIntentPreviewChangeDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreviewChange) {
+		r.Field = v
+	})
+}
+
+// Before is the value of the field before the convention was applied, unset when the
+//
+// convention added the field.
+func (d *PodIntentPreviewChangeDie) Before(v *apiextensionsv1.JSON) *PodIntentPreviewChangeDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreviewChange) {
+		r.Before = v
+	})
+}
+
+// After is the value of the field after the convention was applied, unset when the
+//
+// convention removed the field.
+func (d *PodIntentPreviewChangeDie) After(v *apiextensionsv1.JSON) *PodIntentPreviewChangeDie {
+	return d.DieStamp(func(r *conventionsv1alpha1.PodIntentPreviewChange) {
+		r.After = v
 	})
 }
@@ -63,6 +63,105 @@ func TestClusterPodConventionWebhookCertificateDie_MissingMethods(t *testingx.T)
 	}
 }
 
+func TestClusterPodConventionWebhookBOMsDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionWebhookBOMsBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionWebhookBOMsDie: %s", diff.List())
+	}
+}
+
+func TestClusterPodConventionWebhookServiceAccountTokenDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionWebhookServiceAccountTokenBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionWebhookServiceAccountTokenDie: %s", diff.List())
+	}
+}
+
+func TestClusterPodConventionMutationsDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionMutationsBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionMutationsDie: %s", diff.List())
+	}
+}
+
+func TestClusterPodConventionParamsDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionParamsBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionParamsDie: %s", diff.List())
+	}
+}
+
+func TestClusterPodConventionParamsSourceDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionParamsSourceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionParamsSourceDie: %s", diff.List())
+	}
+}
+
+func TestClusterPodConventionParamsReferenceDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionParamsReferenceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionParamsReferenceDie: %s", diff.List())
+	}
+}
+
+func TestClusterPodConventionResourceDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionResourceBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionResourceDie: %s", diff.List())
+	}
+}
+
+func TestClusterPodConventionStatusDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionStatusDie: %s", diff.List())
+	}
+}
+
+func TestClusterPodConventionCircuitBreakerStatusDie_MissingMethods(t *testingx.T) {
+	die := ClusterPodConventionCircuitBreakerStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ClusterPodConventionCircuitBreakerStatusDie: %s", diff.List())
+	}
+}
+
+func TestConventionProfileDie_MissingMethods(t *testingx.T) {
+	die := ConventionProfileBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ConventionProfileDie: %s", diff.List())
+	}
+}
+
+func TestConventionProfileSpecDie_MissingMethods(t *testingx.T) {
+	die := ConventionProfileSpecBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for ConventionProfileSpecDie: %s", diff.List())
+	}
+}
+
 func TestPodIntentDie_MissingMethods(t *testingx.T) {
 	die := PodIntentBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
@@ -89,3 +188,48 @@ func TestPodIntentStatusDie_MissingMethods(t *testingx.T) {
 		t.Errorf("found missing fields for PodIntentStatusDie: %s", diff.List())
 	}
 }
+
+func TestPodIntentWarningDie_MissingMethods(t *testingx.T) {
+	die := PodIntentWarningBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for PodIntentWarningDie: %s", diff.List())
+	}
+}
+
+func TestPodIntentConventionsDie_MissingMethods(t *testingx.T) {
+	die := PodIntentConventionsBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for PodIntentConventionsDie: %s", diff.List())
+	}
+}
+
+func TestPodIntentPreviewDie_MissingMethods(t *testingx.T) {
+	die := PodIntentPreviewBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for PodIntentPreviewDie: %s", diff.List())
+	}
+}
+
+func TestPodIntentPreviewConventionDie_MissingMethods(t *testingx.T) {
+	die := PodIntentPreviewConventionBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for PodIntentPreviewConventionDie: %s", diff.List())
+	}
+}
+
+func TestPodIntentPreviewChangeDie_MissingMethods(t *testingx.T) {
+	die := PodIntentPreviewChangeBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for PodIntentPreviewChangeDie: %s", diff.List())
+	}
+}
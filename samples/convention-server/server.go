@@ -22,11 +22,15 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/vmware-tanzu/cartographer-conventions/webhook"
 )
@@ -58,7 +62,8 @@ func addEnvVar(container *corev1.Container, envvar corev1.EnvVar) bool {
 }
 
 func main() {
-	ctx := context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "9000"
@@ -71,6 +76,37 @@ func main() {
 	logger := zapr.NewLogger(zapLog)
 	ctx = logr.NewContext(ctx, logger)
 
-	http.HandleFunc("/", webhook.ConventionHandler(ctx, conventionHandler))
-	log.Fatal(webhook.NewConventionServer(ctx, fmt.Sprintf(":%s", port)))
+	opts := []webhook.ConventionServerOption{
+		webhook.WithAddr(fmt.Sprintf(":%s", port)),
+	}
+	if caFile := os.Getenv("CLIENT_CA_FILE"); caFile != "" {
+		// only accept requests from clients with a certificate issued by the CA
+		opts = append(opts, webhook.WithClientCA(caFile))
+	}
+	server := webhook.NewServer(opts...)
+
+	var handler http.Handler = http.HandlerFunc(webhook.ConventionHandler(ctx, conventionHandler))
+	if audience := os.Getenv("TOKEN_AUDIENCE"); audience != "" {
+		// only accept requests from the conventions controller
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			log.Fatalf("failed to load in-cluster config: %v", err)
+		}
+		clientset, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			log.Fatalf("failed to create clientset: %v", err)
+		}
+		handler, err = webhook.RequireServiceAccountToken(webhook.TokenAuthentication{
+			Reviewer: clientset.AuthenticationV1().TokenReviews(),
+			Audience: audience,
+		}, handler)
+		if err != nil {
+			log.Fatalf("failed to require service account tokens: %v", err)
+		}
+	}
+	server.Handle("/", handler)
+
+	if err := server.Start(ctx); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -24,19 +24,10 @@ import (
 	// wokeignore:rule=master
 	"github.com/Masterminds/semver"
 	"k8s.io/apimachinery/pkg/util/sets"
-
-	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
 )
 
-func NewDependenciesBOM(boms []webhookv1alpha1.BOM) DependenciesBOM {
-	d := DependenciesBOM{}
-	for _, b := range boms {
-		// ignore errors, other boms may be in a different structure or not json
-		if cdx, _ := b.AsCycloneDX(); cdx != nil {
-			d.boms = append(d.boms, *cdx)
-		}
-	}
-	return d
+func NewDependenciesBOM(boms []cyclonedx.BOM) DependenciesBOM {
+	return DependenciesBOM{boms: boms}
 }
 
 type DependenciesBOM struct {
@@ -21,9 +21,11 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/cartographer-conventions/webhook/conventions"
 )
 
-var _ Convention = (*SpringBootServiceIntent)(nil)
+var _ conventions.Convention = (*SpringBootServiceIntent)(nil)
 
 type SpringBootServiceIntent struct {
 	Id           string
@@ -35,12 +37,12 @@ func (o *SpringBootServiceIntent) GetId() string {
 	return o.Id
 }
 
-func (o *SpringBootServiceIntent) IsApplicable(ctx context.Context, metadata ImageMetadata) bool {
+func (o *SpringBootServiceIntent) IsApplicable(ctx context.Context, metadata conventions.ImageMetadata) bool {
 	deps := GetDependenciesBOM(ctx)
 	return deps.HasDependency(o.Dependencies...)
 }
 
-func (o *SpringBootServiceIntent) ApplyConvention(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error {
+func (o *SpringBootServiceIntent) ApplyConvention(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
 	deps := GetDependenciesBOM(ctx)
 	for _, d := range o.Dependencies {
 		if dbom := deps.Dependency(d); dbom != nil {
@@ -25,16 +25,19 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/vmware-tanzu/cartographer-conventions/webhook"
+	"github.com/vmware-tanzu/cartographer-conventions/webhook/conventions"
 )
 
-var SpringBootConventions = []Convention{
-	&BasicConvention{
+var SpringBootConventions = []conventions.Convention{
+	&conventions.BasicConvention{
 		Id: "spring-boot",
-		Applicable: func(ctx context.Context, metadata ImageMetadata) bool {
+		Applicable: func(ctx context.Context, metadata conventions.ImageMetadata) bool {
 			deps := GetDependenciesBOM(ctx)
 			return deps.HasDependency("spring-boot")
 		},
-		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error {
+		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
 			deps := GetDependenciesBOM(ctx)
 			setLabel(target, "conventions.carto.run/framework", "spring-boot")
 			if springBootDependency := deps.Dependency("spring-boot"); springBootDependency != nil {
@@ -43,9 +46,9 @@ var SpringBootConventions = []Convention{
 			return nil
 		},
 	},
-	&BasicConvention{
+	&conventions.BasicConvention{
 		Id: "spring-boot-graceful-shutdown",
-		Applicable: func(ctx context.Context, metadata ImageMetadata) bool {
+		Applicable: func(ctx context.Context, metadata conventions.ImageMetadata) bool {
 			deps := GetDependenciesBOM(ctx)
 			return deps.HasDependencyConstraint("spring-boot", ">= 2.3.0-0") && deps.HasDependency(
 				"spring-boot-starter-tomcat",
@@ -54,7 +57,7 @@ var SpringBootConventions = []Convention{
 				"spring-boot-starter-undertow",
 			)
 		},
-		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error {
+		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
 			applicationProperties := GetSpringApplicationProperties(ctx)
 
 			var k8sGracePeriodSeconds int64 = 30 // default k8s grace period is 30 seconds
@@ -68,13 +71,13 @@ var SpringBootConventions = []Convention{
 			return nil
 		},
 	},
-	&BasicConvention{
+	&conventions.BasicConvention{
 		Id: "spring-boot-web",
-		Applicable: func(ctx context.Context, metadata ImageMetadata) bool {
+		Applicable: func(ctx context.Context, metadata conventions.ImageMetadata) bool {
 			deps := GetDependenciesBOM(ctx)
 			return deps.HasDependency("spring-boot") && deps.HasDependency("spring-web")
 		},
-		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error {
+		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
 			applicationProperties := GetSpringApplicationProperties(ctx)
 
 			serverPort := applicationProperties.Default("server.port", "8080")
@@ -98,13 +101,13 @@ var SpringBootConventions = []Convention{
 			return nil
 		},
 	},
-	&BasicConvention{
+	&conventions.BasicConvention{
 		Id: "spring-boot-actuator",
-		Applicable: func(ctx context.Context, metadata ImageMetadata) bool {
+		Applicable: func(ctx context.Context, metadata conventions.ImageMetadata) bool {
 			deps := GetDependenciesBOM(ctx)
 			return deps.HasDependency("spring-boot-actuator")
 		},
-		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error {
+		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
 			applicationProperties := GetSpringApplicationProperties(ctx)
 
 			managementPort := applicationProperties.Default("management.server.port", applicationProperties["server.port"])
@@ -120,18 +123,19 @@ var SpringBootConventions = []Convention{
 			return nil
 		},
 	},
-	&BasicConvention{
+	&conventions.BasicConvention{
 		Id: "spring-boot-actuator-probes",
-		Applicable: func(ctx context.Context, metadata ImageMetadata) bool {
+		Applicable: func(ctx context.Context, metadata conventions.ImageMetadata) bool {
 			deps := GetDependenciesBOM(ctx)
 			return deps.HasDependency("spring-boot-actuator")
 		},
-		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error {
+		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
 			deps := GetDependenciesBOM(ctx)
 			applicationProperties := GetSpringApplicationProperties(ctx)
 
 			if v := applicationProperties.Default("management.health.probes.enabled", "true"); v != "true" {
 				// management health probes were deactivated by the user, skip
+				webhook.AddWarning(ctx, "management health probes are disabled for container %q, no probes were added", target.Spec.Containers[containerIdx].Name)
 				return nil
 			}
 
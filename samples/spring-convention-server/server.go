@@ -30,43 +30,28 @@ import (
 
 	"github.com/vmware-tanzu/cartographer-conventions/samples/spring-convention-server/resources"
 	"github.com/vmware-tanzu/cartographer-conventions/webhook"
+	"github.com/vmware-tanzu/cartographer-conventions/webhook/conventions"
 )
 
-func addSpringBootConventions(template *corev1.PodTemplateSpec, images []webhook.ImageConfig) ([]string, error) {
-	imageMap := make(map[string]webhook.ImageConfig)
-	for _, config := range images {
-		imageMap[config.Image] = config
-	}
-
-	var appliedConventions []string
-	for i := range template.Spec.Containers {
-		// TODO how to best handle multiple spring boot containers?
-		container := &template.Spec.Containers[i]
-		image, ok := imageMap[container.Image]
-		if !ok {
-			// skip containers without metadata, this may be a container without an image
-			continue
-		}
-		dependencyMetadata := resources.NewDependenciesBOM(image.BOMs)
-		applicationProperties := resources.SpringApplicationProperties{}
-		applicationProperties.FromContainer(container)
+func springBootConventions() *conventions.Registry {
+	registry := &conventions.Registry{}
+	registry.RegisterStash(conventions.ContainerStash{
+		Stash: func(ctx context.Context, container *corev1.Container, image webhook.ImageConfig) (context.Context, error) {
+			dependencyMetadata := resources.NewDependenciesBOM(conventions.GetCycloneDXBOMs(ctx))
+			applicationProperties := resources.SpringApplicationProperties{}
+			applicationProperties.FromContainer(container)
 
-		ctx := context.Background()
-		ctx = resources.StashSpringApplicationProperties(ctx, applicationProperties)
-		ctx = resources.StashDependenciesBOM(ctx, &dependencyMetadata)
-		for _, o := range resources.SpringBootConventions {
-			// need to continue refining what metadata is passed to conventions
-			if !o.IsApplicable(ctx, imageMap) {
-				continue
-			}
-			appliedConventions = append(appliedConventions, o.GetId())
-			if err := o.ApplyConvention(ctx, template, i, imageMap); err != nil {
-				return nil, err
-			}
-		}
-		applicationProperties.ToContainer(container)
-	}
-	return appliedConventions, nil
+			ctx = resources.StashSpringApplicationProperties(ctx, applicationProperties)
+			ctx = resources.StashDependenciesBOM(ctx, &dependencyMetadata)
+			return ctx, nil
+		},
+		Done: func(ctx context.Context, container *corev1.Container) error {
+			resources.GetSpringApplicationProperties(ctx).ToContainer(container)
+			return nil
+		},
+	})
+	registry.Register(resources.SpringBootConventions...)
+	return registry
 }
 
 func main() {
@@ -84,6 +69,6 @@ func main() {
 	logger := zapr.NewLogger(zapLog)
 	ctx = logr.NewContext(ctx, logger)
 
-	http.HandleFunc("/", webhook.ConventionHandler(ctx, addSpringBootConventions))
+	http.HandleFunc("/", webhook.ContextConventionHandler(ctx, springBootConventions().ApplyContext))
 	log.Fatal(webhook.NewConventionServer(ctx, fmt.Sprintf(":%s", port)))
 }
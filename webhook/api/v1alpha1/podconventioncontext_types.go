@@ -18,22 +18,117 @@ package v1alpha1
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/CycloneDX/cyclonedx-go"
 	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type PodConventionContextSpec struct {
 	Template    corev1.PodTemplateSpec `json:"template"`
 	ImageConfig []ImageConfig          `json:"imageConfig"`
+	// Params configure the convention, resolved by the controller from the ClusterPodConvention
+	// and overrides in the PodIntent's namespace.
+	Params map[string]string `json:"params,omitempty"`
+	// Resources are the resources the ClusterPodConvention declares, read by the controller from
+	// the PodIntent's namespace. Secrets are sent as metadata only.
+	Resources []unstructured.Unstructured `json:"resources,omitempty"`
+	// ExcludedConventions are the ids of the conventions within the ClusterPodConvention the
+	// PodIntent excludes, they should not be applied.
+	ExcludedConventions []string `json:"excludedConventions,omitempty"`
 }
 
 type PodConventionContextStatus struct {
 	Template           corev1.PodTemplateSpec `json:"template"`
 	AppliedConventions []string               `json:"appliedConventions"`
+	// Warnings are shown to the developer of the workload, they do not fail the convention.
+	Warnings []string `json:"warnings,omitempty"`
+	// Patch is an RFC 6902 JSON Patch applied by the controller to the requested template. When
+	// set, the template in the status is ignored.
+	Patch []byte `json:"patch,omitempty"`
+	// PatchType is the type of the patch, only JSONPatch is supported.
+	PatchType *PatchType `json:"patchType,omitempty"`
+	// Error is set when the convention failed, the template and patch are ignored.
+	Error *ConventionError `json:"error,omitempty"`
+	// Validation is the outcome of a convention in Validate mode, the template and patch are
+	// ignored.
+	Validation *ConventionValidation `json:"validation,omitempty"`
+}
+
+type PatchType string
+
+const (
+	JSONPatchType PatchType = "JSONPatch"
+)
+
+const (
+	// ConventionFailedReason is the reason of errors returned by a convention that do not
+	// describe themselves.
+	ConventionFailedReason = "ConventionFailed"
+	// ConventionPanicReason is the reason of a convention that panicked.
+	ConventionPanicReason = "ConventionPanic"
+	// InvalidRequestReason is the reason of a request that is not a PodConventionContext.
+	InvalidRequestReason = "InvalidRequest"
+	// RequestTooLargeReason is the reason of a request larger than the convention server accepts.
+	RequestTooLargeReason = "RequestTooLarge"
+	// ConventionTimeoutReason is the reason of a convention that did not complete in time.
+	ConventionTimeoutReason = "ConventionTimeout"
+)
+
+// ConventionError describes why a convention failed. Conventions may return a ConventionError
+// to control the response, other errors are reported as retryable with the ConventionFailed
+// reason.
+type ConventionError struct {
+	// Reason is a CamelCase, machine readable, cause of the failure.
+	Reason string `json:"reason"`
+	// Message is a human readable description of the failure.
+	Message string `json:"message,omitempty"`
+	// Retryable indicates the same request may succeed when retried, otherwise the controller
+	// waits for the workload or the convention to change.
+	Retryable bool `json:"retryable"`
+	// Field is the path of the field in the workload's template that caused the failure, if
+	// any, like spec.containers[0].image.
+	Field string `json:"field,omitempty"`
+}
+
+func (e *ConventionError) Error() string {
+	msg := e.Reason
+	if e.Message != "" {
+		msg = fmt.Sprintf("%s: %s", msg, e.Message)
+	}
+	if e.Field != "" {
+		msg = fmt.Sprintf("%s (field %s)", msg, e.Field)
+	}
+	return msg
+}
+
+// ConventionValidation is the decision of a convention in Validate mode on the template, after
+// all mutating conventions are applied.
+type ConventionValidation struct {
+	// Allowed is true when the template complies with the convention's policy.
+	Allowed bool `json:"allowed"`
+	// Reasons describe why the template is denied.
+	Reasons []ValidationReason `json:"reasons,omitempty"`
+}
+
+// ValidationReason describes why a template is denied.
+type ValidationReason struct {
+	// Message is a human readable description of the violation.
+	Message string `json:"message"`
+	// Field is the path of the field in the workload's template that is denied, if any, like
+	// spec.containers[0].securityContext.privileged.
+	Field string `json:"field,omitempty"`
+}
+
+func (r ValidationReason) String() string {
+	if r.Field == "" {
+		return r.Message
+	}
+	return fmt.Sprintf("%s: %s", r.Field, r.Message)
 }
 
 type ImageConfig struct {
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ControllerUsername is the user the conventions controller authenticates as in the default
+// installation.
+const ControllerUsername = "system:serviceaccount:conventions-system:cartographer-conventions-controller-manager"
+
+// TokenReviewer creates TokenReviews, like the client returned by
+// clientset.AuthenticationV1().TokenReviews(). The convention server's service account must be
+// allowed to create TokenReviews, for example by binding the system:auth-delegator ClusterRole.
+type TokenReviewer interface {
+	Create(ctx context.Context, tokenReview *authenticationv1.TokenReview, opts metav1.CreateOptions) (*authenticationv1.TokenReview, error)
+}
+
+// TokenAuthentication configures how callers are authenticated by RequireServiceAccountToken.
+type TokenAuthentication struct {
+	// Reviewer is required.
+	Reviewer TokenReviewer
+	// Audience the token must be issued for, the audience of the ClusterPodConvention's
+	// serviceAccountToken. Required, as tokens for any audience would otherwise be reviewed for
+	// the API server's audience.
+	Audience string
+	// AllowedUsers are the users allowed to call the convention, ControllerUsername when empty.
+	AllowedUsers []string
+	// CacheDuration is how long a reviewed token is trusted before it is reviewed again, one
+	// minute by default.
+	CacheDuration time.Duration
+}
+
+// RequireServiceAccountToken only passes requests to the handler that carry a bearer token for
+// an allowed user, issued for the audience. Other requests are rejected as Unauthorized, or
+// Forbidden when the token is valid but the user is not allowed. An error is returned when the
+// reviewer or audience are missing.
+func RequireServiceAccountToken(auth TokenAuthentication, handler http.Handler) (http.Handler, error) {
+	if auth.Reviewer == nil {
+		return nil, fmt.Errorf("a token reviewer is required")
+	}
+	if auth.Audience == "" {
+		return nil, fmt.Errorf("an audience is required")
+	}
+	if len(auth.AllowedUsers) == 0 {
+		auth.AllowedUsers = []string{ControllerUsername}
+	}
+	if auth.CacheDuration == 0 {
+		auth.CacheDuration = time.Minute
+	}
+	cache := &reviewCache{entries: map[[sha256.Size]byte]reviewCacheEntry{}}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := logr.FromContextOrDiscard(r.Context())
+
+		scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !strings.EqualFold(scheme, "Bearer") || token == "" {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		key := sha256.Sum256([]byte(token))
+		username, ok := cache.get(key)
+		if !ok {
+			review, err := auth.Reviewer.Create(r.Context(), &authenticationv1.TokenReview{
+				Spec: authenticationv1.TokenReviewSpec{
+					Token:     token,
+					Audiences: []string{auth.Audience},
+				},
+			}, metav1.CreateOptions{})
+			if err != nil {
+				logger.Error(err, "failed to review token")
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if !review.Status.Authenticated || !slices.Contains(review.Status.Audiences, auth.Audience) {
+				logger.Info("rejected unauthenticated request", "error", review.Status.Error)
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			username = review.Status.User.Username
+			cache.add(key, username, auth.CacheDuration)
+		}
+		if !slices.Contains(auth.AllowedUsers, username) {
+			logger.Info("rejected request from user that is not allowed", "user", username)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}), nil
+}
+
+type reviewCache struct {
+	m       sync.Mutex
+	entries map[[sha256.Size]byte]reviewCacheEntry
+}
+
+type reviewCacheEntry struct {
+	username string
+	expires  time.Time
+}
+
+func (c *reviewCache) get(key [sha256.Size]byte) (string, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return "", false
+	}
+	return entry.username, true
+}
+
+func (c *reviewCache) add(key [sha256.Size]byte, username string, ttl time.Duration) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	now := time.Now()
+	for k, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = reviewCacheEntry{username: username, expires: now.Add(ttl)}
+}
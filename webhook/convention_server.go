@@ -0,0 +1,269 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// ConventionServerOption customizes a ConventionServer.
+type ConventionServerOption func(*conventionServerOptions)
+
+type conventionServerOptions struct {
+	addr            string
+	listener        net.Listener
+	mux             *http.ServeMux
+	certFile        string
+	keyFile         string
+	clientCAFile    string
+	insecure        bool
+	minTLSVersion   uint16
+	shutdownTimeout time.Duration
+}
+
+// WithAddr is the address the server listens on, ":8443" by default.
+func WithAddr(addr string) ConventionServerOption {
+	return func(o *conventionServerOptions) {
+		o.addr = addr
+	}
+}
+
+// WithListener serves on an existing listener instead of listening on an address.
+func WithListener(listener net.Listener) ConventionServerOption {
+	return func(o *conventionServerOptions) {
+		o.listener = listener
+	}
+}
+
+// WithServeMux registers handlers on the mux rather than a mux owned by the server. The server
+// answers /healthz itself unless the mux serves that path.
+func WithServeMux(mux *http.ServeMux) ConventionServerOption {
+	return func(o *conventionServerOptions) {
+		o.mux = mux
+	}
+}
+
+// WithCertificate is the PEM encoded serving certificate and key, tls.crt and tls.key in
+// CertMountPath by default. The files are reloaded periodically, so they may be rotated.
+func WithCertificate(certFile, keyFile string) ConventionServerOption {
+	return func(o *conventionServerOptions) {
+		o.certFile = certFile
+		o.keyFile = keyFile
+	}
+}
+
+// WithClientCA requires clients to present a certificate signed by a CA in the PEM encoded file,
+// like the CA issuing the controller's client certificate. The file is reloaded along with the
+// serving certificate.
+func WithClientCA(caFile string) ConventionServerOption {
+	return func(o *conventionServerOptions) {
+		o.clientCAFile = caFile
+	}
+}
+
+// WithInsecure serves plain HTTP, for local development only. The controller only calls
+// conventions over HTTPS.
+func WithInsecure() ConventionServerOption {
+	return func(o *conventionServerOptions) {
+		o.insecure = true
+	}
+}
+
+// WithMinTLSVersion is the minimum TLS version accepted, tls.VersionTLS13 by default.
+func WithMinTLSVersion(version uint16) ConventionServerOption {
+	return func(o *conventionServerOptions) {
+		o.minTLSVersion = version
+	}
+}
+
+// WithShutdownTimeout is how long in-flight requests may take to complete once the server's
+// context is done, thirty seconds by default.
+func WithShutdownTimeout(timeout time.Duration) ConventionServerOption {
+	return func(o *conventionServerOptions) {
+		o.shutdownTimeout = timeout
+	}
+}
+
+// ConventionServer hosts one or more conventions, each on its own path, along with a /healthz
+// endpoint.
+type ConventionServer struct {
+	options conventionServerOptions
+	mux     *http.ServeMux
+}
+
+// NewServer creates a server, register conventions with HandleConvention before starting it.
+func NewServer(opts ...ConventionServerOption) *ConventionServer {
+	options := conventionServerOptions{
+		addr:            ":8443",
+		certFile:        filepath.Join(CertMountPath, "tls.crt"),
+		keyFile:         filepath.Join(CertMountPath, "tls.key"),
+		minTLSVersion:   tls.VersionTLS13,
+		shutdownTimeout: 30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	mux := options.mux
+	if mux == nil {
+		mux = http.NewServeMux()
+	}
+
+	return &ConventionServer{
+		options: options,
+		mux:     mux,
+	}
+}
+
+// HandleConvention serves the convention at the path.
+func (s *ConventionServer) HandleConvention(path string, convention Convention, opts ...ConventionHandlerOption) {
+	s.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		// the request context carries the server's logger
+		ConventionHandler(r.Context(), convention, opts...)(w, r)
+	})
+}
+
+// HandleContextConvention serves the context aware convention at the path.
+func (s *ConventionServer) HandleContextConvention(path string, convention ContextConvention, opts ...ConventionHandlerOption) {
+	s.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		ContextConventionHandler(r.Context(), convention, opts...)(w, r)
+	})
+}
+
+// HandleValidator serves the validator at the path, for a ClusterPodConvention in Validate mode.
+func (s *ConventionServer) HandleValidator(path string, validator Validator, opts ...ConventionHandlerOption) {
+	s.mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		ValidatorHandler(r.Context(), validator, opts...)(w, r)
+	})
+}
+
+// Handle serves the handler at the pattern, for conventions wrapped in middleware like
+// RequireServiceAccountToken.
+func (s *ConventionServer) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+func (s *ConventionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/healthz" {
+		// the mux may be shared, rather than registering the endpoint defer to one already served
+		if _, pattern := s.mux.Handler(r); !strings.HasSuffix(pattern, "/healthz") {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// Start serves requests until the context is done, then stops accepting new connections and
+// waits for in-flight requests to complete before returning.
+func (s *ConventionServer) Start(ctx context.Context) error {
+	logger := logr.FromContextOrDiscard(ctx)
+	if s.options.insecure && s.options.clientCAFile != "" {
+		return fmt.Errorf("client certificates require TLS")
+	}
+
+	server := &http.Server{
+		Addr:    s.options.addr,
+		Handler: s,
+		BaseContext: func(_ net.Listener) context.Context {
+			// in-flight requests are drained rather than canceled on shutdown
+			return context.WithoutCancel(ctx)
+		},
+	}
+	if !s.options.insecure {
+		tlsConfig, err := s.tlsConfig(ctx)
+		if err != nil {
+			return err
+		}
+		server.TLSConfig = tlsConfig
+	}
+
+	listener := s.options.listener
+	if listener == nil {
+		var err error
+		listener, err = net.Listen("tcp", s.options.addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	shutdown := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		logger.Info("shutting down convention server")
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.options.shutdownTimeout)
+		defer cancel()
+		shutdown <- server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Info("starting convention server", "addr", listener.Addr().String(), "tls", !s.options.insecure)
+	var err error
+	if s.options.insecure {
+		err = server.Serve(listener)
+	} else {
+		err = server.ServeTLS(listener, "", "")
+	}
+	if !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return <-shutdown
+}
+
+func (s *ConventionServer) tlsConfig(ctx context.Context) (*tls.Config, error) {
+	watcher := &certWatcher{
+		CrtFile: s.options.certFile,
+		KeyFile: s.options.keyFile,
+		CAFile:  s.options.clientCAFile,
+	}
+	if err := watcher.Load(ctx); err != nil {
+		return nil, err
+	}
+	go watcher.Watch(ctx)
+
+	tlsConfig := func() *tls.Config {
+		config := &tls.Config{
+			GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+				cert := watcher.GetCertificate()
+				return cert, nil
+			},
+			PreferServerCipherSuites: true,
+			MinVersion:               s.options.minTLSVersion,
+		}
+		if s.options.clientCAFile != "" {
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+			config.ClientCAs = watcher.GetClientCAs()
+		}
+		return config
+	}
+	config := tlsConfig()
+	if s.options.clientCAFile != "" {
+		// pick up the current client CAs for each connection
+		config.GetConfigForClient = func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+			return tlsConfig(), nil
+		}
+	}
+	return config, nil
+}
@@ -0,0 +1,320 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/vmware-tanzu/cartographer-conventions/webhook"
+)
+
+// testCerts are the files of a CA, and a serving and client certificate issued by it.
+type testCerts struct {
+	caFile         string
+	serverCertFile string
+	serverKeyFile  string
+	clientCertFile string
+	clientKeyFile  string
+	pool           *x509.CertPool
+}
+
+func newTestCerts(t *testing.T) *testCerts {
+	t.Helper()
+	dir := t.TempDir()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("unable to create CA certificate: %v", err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatalf("unable to parse CA certificate: %v", err)
+	}
+
+	certs := &testCerts{
+		caFile:         filepath.Join(dir, "ca.crt"),
+		serverCertFile: filepath.Join(dir, "tls.crt"),
+		serverKeyFile:  filepath.Join(dir, "tls.key"),
+		clientCertFile: filepath.Join(dir, "client.crt"),
+		clientKeyFile:  filepath.Join(dir, "client.key"),
+		pool:           x509.NewCertPool(),
+	}
+	certs.pool.AddCert(ca)
+	writePEM(t, certs.caFile, "CERTIFICATE", caDER)
+
+	issue := func(serial int64, template *x509.Certificate, certFile, keyFile string) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatalf("unable to generate key: %v", err)
+		}
+		template.SerialNumber = big.NewInt(serial)
+		template.NotBefore = time.Now().Add(-time.Hour)
+		template.NotAfter = time.Now().Add(time.Hour)
+		template.KeyUsage = x509.KeyUsageDigitalSignature
+		der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+		if err != nil {
+			t.Fatalf("unable to create certificate: %v", err)
+		}
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			t.Fatalf("unable to marshal key: %v", err)
+		}
+		writePEM(t, certFile, "CERTIFICATE", der)
+		writePEM(t, keyFile, "EC PRIVATE KEY", keyDER)
+	}
+	issue(2, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "convention-server"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, certs.serverCertFile, certs.serverKeyFile)
+	issue(3, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "conventions-controller"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, certs.clientCertFile, certs.clientKeyFile)
+
+	return certs
+}
+
+func writePEM(t *testing.T, file, blockType string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600); err != nil {
+		t.Fatalf("unable to write %s: %v", file, err)
+	}
+}
+
+// startServer starts the server on a local port, returning its URL, a func to stop the server and
+// the result of starting it. The server is stopped when the test completes.
+func startServer(t *testing.T, server func(listener net.Listener) *webhook.ConventionServer) (string, context.CancelFunc, <-chan error) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unable to listen: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		done <- server(listener).Start(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-stopped
+	})
+	return "https://" + listener.Addr().String(), cancel, done
+}
+
+func TestConventionServerHealthz(t *testing.T) {
+	tests := []struct {
+		name          string
+		mux           func() *http.ServeMux
+		expectsStatus int
+	}{{
+		name:          "server owned mux",
+		expectsStatus: http.StatusOK,
+	}, {
+		name: "shared mux with a catch all handler",
+		mux: func() *http.ServeMux {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			})
+			return mux
+		},
+		expectsStatus: http.StatusOK,
+	}, {
+		name: "shared mux with its own health endpoint",
+		mux: func() *http.ServeMux {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+			return mux
+		},
+		expectsStatus: http.StatusNoContent,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := []webhook.ConventionServerOption{}
+			if test.mux != nil {
+				mux := test.mux()
+				// servers sharing a mux must not register conflicting handlers
+				webhook.NewServer(webhook.WithServeMux(mux))
+				opts = append(opts, webhook.WithServeMux(mux))
+			}
+			server := webhook.NewServer(opts...)
+
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+			if w.Code != test.expectsStatus {
+				t.Errorf("expected status %d, got %d", test.expectsStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestConventionServerTLS(t *testing.T) {
+	certs := newTestCerts(t)
+	clientCert, err := tls.LoadX509KeyPair(certs.clientCertFile, certs.clientKeyFile)
+	if err != nil {
+		t.Fatalf("unable to load client certificate: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		opts         []webhook.ConventionServerOption
+		clientConfig *tls.Config
+		expectsErr   bool
+	}{{
+		name:         "default minimum version",
+		clientConfig: &tls.Config{RootCAs: certs.pool},
+	}, {
+		name:         "client below the default minimum version",
+		clientConfig: &tls.Config{RootCAs: certs.pool, MaxVersion: tls.VersionTLS12},
+		expectsErr:   true,
+	}, {
+		name:         "lowered minimum version",
+		opts:         []webhook.ConventionServerOption{webhook.WithMinTLSVersion(tls.VersionTLS12)},
+		clientConfig: &tls.Config{RootCAs: certs.pool, MaxVersion: tls.VersionTLS12},
+	}, {
+		name:         "client certificate required",
+		opts:         []webhook.ConventionServerOption{webhook.WithClientCA(certs.caFile)},
+		clientConfig: &tls.Config{RootCAs: certs.pool},
+		expectsErr:   true,
+	}, {
+		name:         "client certificate verified",
+		opts:         []webhook.ConventionServerOption{webhook.WithClientCA(certs.caFile)},
+		clientConfig: &tls.Config{RootCAs: certs.pool, Certificates: []tls.Certificate{clientCert}},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			url, _, _ := startServer(t, func(listener net.Listener) *webhook.ConventionServer {
+				opts := append([]webhook.ConventionServerOption{
+					webhook.WithListener(listener),
+					webhook.WithCertificate(certs.serverCertFile, certs.serverKeyFile),
+				}, test.opts...)
+				return webhook.NewServer(opts...)
+			})
+
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: test.clientConfig}}
+			res, err := client.Get(url + "/healthz")
+			if err == nil {
+				res.Body.Close()
+			}
+			if test.expectsErr {
+				if err == nil {
+					t.Errorf("expected the request to fail, got status %d", res.StatusCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if res.StatusCode != http.StatusOK {
+				t.Errorf("expected status %d, got %d", http.StatusOK, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestConventionServerInsecureClientCA(t *testing.T) {
+	server := webhook.NewServer(webhook.WithInsecure(), webhook.WithClientCA("ca.crt"))
+	if err := server.Start(context.Background()); err == nil {
+		t.Errorf("Start() expected error for client certificates without TLS")
+	}
+}
+
+func TestConventionServerGracefulShutdown(t *testing.T) {
+	certs := newTestCerts(t)
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	url, cancel, done := startServer(t, func(listener net.Listener) *webhook.ConventionServer {
+		server := webhook.NewServer(
+			webhook.WithListener(listener),
+			webhook.WithCertificate(certs.serverCertFile, certs.serverKeyFile),
+		)
+		server.Handle("/slow", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			close(started)
+			<-release
+			w.WriteHeader(http.StatusOK)
+		}))
+		return server
+	})
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: certs.pool}}}
+	responses := make(chan *http.Response, 1)
+	errs := make(chan error, 1)
+	go func() {
+		res, err := client.Get(url + "/slow")
+		if err != nil {
+			errs <- err
+			return
+		}
+		res.Body.Close()
+		responses <- res
+	}()
+	<-started
+
+	cancel()
+	select {
+	case err := <-done:
+		t.Fatalf("Start() returned before the in-flight request completed: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+
+	select {
+	case res := <-responses:
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("expected status %d, got %d", http.StatusOK, res.StatusCode)
+		}
+	case err := <-errs:
+		t.Fatalf("in-flight request failed: %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("Start() unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conventions
+
+import (
+	"context"
+
+	"github.com/CycloneDX/cyclonedx-go"
+	corev1 "k8s.io/api/core/v1"
+
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+)
+
+type stashKey[T any] struct{}
+
+// StashValue stashes a value in the context, keyed by its type. Conventions retrieve the value
+// with GetValue.
+func StashValue[T any](ctx context.Context, value T) context.Context {
+	return context.WithValue(ctx, stashKey[T]{}, value)
+}
+
+// GetValue returns the value of the type stashed in the context, if any.
+func GetValue[T any](ctx context.Context) (T, bool) {
+	value, ok := ctx.Value(stashKey[T]{}).(T)
+	return value, ok
+}
+
+type containerKey struct{}
+
+// containerRef refers to a container by name, as a pointer into the template's containers is
+// stale once a convention adds a container.
+type containerRef struct {
+	template *corev1.PodTemplateSpec
+	name     string
+}
+
+func stashContainer(ctx context.Context, template *corev1.PodTemplateSpec, name string) context.Context {
+	return context.WithValue(ctx, containerKey{}, containerRef{template: template, name: name})
+}
+
+// GetContainer returns the container conventions are being applied to, or nil when applying
+// pod conventions. The container is looked up by name in the template each time, retrieve it
+// again after changing the template's containers.
+func GetContainer(ctx context.Context) *corev1.Container {
+	ref, ok := ctx.Value(containerKey{}).(containerRef)
+	if !ok {
+		return nil
+	}
+	return findContainer(ref.template, ref.name)
+}
+
+func findContainer(template *corev1.PodTemplateSpec, name string) *corev1.Container {
+	for i := range template.Spec.Containers {
+		if template.Spec.Containers[i].Name == name {
+			return &template.Spec.Containers[i]
+		}
+	}
+	return nil
+}
+
+type imageConfigKey struct{}
+
+func stashImageConfig(ctx context.Context, image webhookv1alpha1.ImageConfig) context.Context {
+	return context.WithValue(ctx, imageConfigKey{}, image)
+}
+
+// GetImageConfig returns the image config of the container conventions are being applied to.
+func GetImageConfig(ctx context.Context) (webhookv1alpha1.ImageConfig, bool) {
+	image, ok := ctx.Value(imageConfigKey{}).(webhookv1alpha1.ImageConfig)
+	return image, ok
+}
+
+type cycloneDXBOMsKey struct{}
+
+func stashCycloneDXBOMs(ctx context.Context, boms []webhookv1alpha1.BOM) context.Context {
+	var parsed []cyclonedx.BOM
+	for _, b := range boms {
+		// ignore errors, other boms may be in a different structure or not json
+		if cdx, _ := b.AsCycloneDX(); cdx != nil {
+			parsed = append(parsed, *cdx)
+		}
+	}
+	return context.WithValue(ctx, cycloneDXBOMsKey{}, parsed)
+}
+
+// GetCycloneDXBOMs returns the BOMs of the container's image that parse as CycloneDX. BOMs are
+// parsed once per container, rather than by each convention.
+func GetCycloneDXBOMs(ctx context.Context) []cyclonedx.BOM {
+	boms, _ := ctx.Value(cycloneDXBOMsKey{}).([]cyclonedx.BOM)
+	return boms
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conventions is a framework for convention servers that apply many small conventions,
+// each deciding for itself whether it applies. Conventions are collected in a Registry, whose
+// ApplyContext method is a webhook.ContextConvention.
+package conventions
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+)
+
+// ImageMetadata is the image config of the pod's containers, keyed by image.
+type ImageMetadata = map[string]webhookv1alpha1.ImageConfig
+
+// Convention is applied to each container in the pod that has image metadata.
+type Convention interface {
+	GetId() string
+	IsApplicable(ctx context.Context, metadata ImageMetadata) bool
+	ApplyConvention(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error
+}
+
+// PodConvention is applied once to the pod, rather than to each container.
+type PodConvention interface {
+	GetId() string
+	IsApplicable(ctx context.Context, metadata ImageMetadata) bool
+	ApplyPodConvention(ctx context.Context, target *corev1.PodTemplateSpec, metadata ImageMetadata) error
+}
+
+var _ Convention = (*BasicConvention)(nil)
+
+// BasicConvention is a Convention defined by funcs. A convention without an Applicable
+// predicate applies to every container.
+type BasicConvention struct {
+	Id         string
+	Applicable Predicate
+	Apply      func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error
+}
+
+func (o *BasicConvention) GetId() string {
+	return o.Id
+}
+
+func (o *BasicConvention) IsApplicable(ctx context.Context, metadata ImageMetadata) bool {
+	if o.Applicable == nil {
+		return true
+	}
+	return o.Applicable(ctx, metadata)
+}
+
+func (o *BasicConvention) ApplyConvention(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata ImageMetadata) error {
+	return o.Apply(ctx, target, containerIdx, metadata)
+}
+
+var _ PodConvention = (*BasicPodConvention)(nil)
+
+// BasicPodConvention is a PodConvention defined by funcs. A convention without an Applicable
+// predicate applies to every pod.
+type BasicPodConvention struct {
+	Id         string
+	Applicable Predicate
+	Apply      func(ctx context.Context, target *corev1.PodTemplateSpec, metadata ImageMetadata) error
+}
+
+func (o *BasicPodConvention) GetId() string {
+	return o.Id
+}
+
+func (o *BasicPodConvention) IsApplicable(ctx context.Context, metadata ImageMetadata) bool {
+	if o.Applicable == nil {
+		return true
+	}
+	return o.Applicable(ctx, metadata)
+}
+
+func (o *BasicPodConvention) ApplyPodConvention(ctx context.Context, target *corev1.PodTemplateSpec, metadata ImageMetadata) error {
+	return o.Apply(ctx, target, metadata)
+}
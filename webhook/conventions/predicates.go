@@ -0,0 +1,87 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conventions
+
+import (
+	"context"
+	"slices"
+)
+
+// Predicate decides whether a convention applies.
+type Predicate func(ctx context.Context, metadata ImageMetadata) bool
+
+// And applies when all of the predicates apply.
+func And(predicates ...Predicate) Predicate {
+	return func(ctx context.Context, metadata ImageMetadata) bool {
+		for _, p := range predicates {
+			if !p(ctx, metadata) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or applies when any of the predicates apply.
+func Or(predicates ...Predicate) Predicate {
+	return func(ctx context.Context, metadata ImageMetadata) bool {
+		for _, p := range predicates {
+			if p(ctx, metadata) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not applies when the predicate does not.
+func Not(predicate Predicate) Predicate {
+	return func(ctx context.Context, metadata ImageMetadata) bool {
+		return !predicate(ctx, metadata)
+	}
+}
+
+// HasImageLabel applies when the container's image has the label, with the value if any values
+// are given.
+func HasImageLabel(key string, values ...string) Predicate {
+	return func(ctx context.Context, metadata ImageMetadata) bool {
+		image, ok := GetImageConfig(ctx)
+		if !ok {
+			return false
+		}
+		value, ok := image.Config.Config.Labels[key]
+		return ok && (len(values) == 0 || slices.Contains(values, value))
+	}
+}
+
+// HasDependency applies when a CycloneDX BOM of the container's image has a component with
+// any of the names.
+func HasDependency(names ...string) Predicate {
+	return func(ctx context.Context, metadata ImageMetadata) bool {
+		for _, b := range GetCycloneDXBOMs(ctx) {
+			if b.Components == nil {
+				continue
+			}
+			for _, c := range *b.Components {
+				if slices.Contains(names, c.Name) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conventions_test
+
+import (
+	"context"
+	"testing"
+
+	ggcrv1 "github.com/google/go-containerregistry/pkg/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+	"github.com/vmware-tanzu/cartographer-conventions/webhook/conventions"
+)
+
+const springBootBOM = `{
+	"bomFormat": "CycloneDX",
+	"specVersion": "1.4",
+	"components": [
+		{"type": "library", "name": "spring-boot", "version": "3.2.0"},
+		{"type": "library", "name": "spring-web", "version": "6.1.1"}
+	]
+}`
+
+func labeledImage(labels map[string]string, boms ...webhookv1alpha1.BOM) webhookv1alpha1.ImageConfig {
+	return webhookv1alpha1.ImageConfig{
+		Image: "registry.example/app@sha256:0000",
+		BOMs:  boms,
+		Config: ggcrv1.ConfigFile{
+			Config: ggcrv1.Config{Labels: labels},
+		},
+	}
+}
+
+// evaluate evaluates the predicate for a container with the image, as the registry does.
+func evaluate(t *testing.T, predicate conventions.Predicate, image webhookv1alpha1.ImageConfig) bool {
+	t.Helper()
+	var applies bool
+	registry := &conventions.Registry{}
+	registry.Register(&conventions.BasicConvention{
+		Id: "probe",
+		Applicable: func(ctx context.Context, metadata conventions.ImageMetadata) bool {
+			applies = predicate(ctx, metadata)
+			return false
+		},
+	})
+	template := &corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "workload", Image: image.Image}},
+		},
+	}
+	if _, err := registry.ApplyContext(context.Background(), template, []webhookv1alpha1.ImageConfig{image}); err != nil {
+		t.Fatalf("ApplyContext() unexpected error: %v", err)
+	}
+	return applies
+}
+
+func TestPredicates(t *testing.T) {
+	always := func(ctx context.Context, metadata conventions.ImageMetadata) bool { return true }
+	never := func(ctx context.Context, metadata conventions.ImageMetadata) bool { return false }
+	plain := labeledImage(map[string]string{"app.kubernetes.io/name": "petclinic"})
+	boot := labeledImage(nil, webhookv1alpha1.BOM{Name: "cnb-app:layers/sbom/launch/sbom.cdx.json", Raw: []byte(springBootBOM)})
+	notCycloneDX := labeledImage(nil, webhookv1alpha1.BOM{Name: "cnb-app:layers/sbom/launch/sbom.syft.json", Raw: []byte(`not json`)})
+
+	tests := []struct {
+		name      string
+		predicate conventions.Predicate
+		image     webhookv1alpha1.ImageConfig
+		expects   bool
+	}{{
+		name:      "and all",
+		predicate: conventions.And(always, always),
+		image:     plain,
+		expects:   true,
+	}, {
+		name:      "and some",
+		predicate: conventions.And(always, never),
+		image:     plain,
+		expects:   false,
+	}, {
+		name:      "and none given",
+		predicate: conventions.And(),
+		image:     plain,
+		expects:   true,
+	}, {
+		name:      "or some",
+		predicate: conventions.Or(never, always),
+		image:     plain,
+		expects:   true,
+	}, {
+		name:      "or none",
+		predicate: conventions.Or(never, never),
+		image:     plain,
+		expects:   false,
+	}, {
+		name:      "or none given",
+		predicate: conventions.Or(),
+		image:     plain,
+		expects:   false,
+	}, {
+		name:      "not",
+		predicate: conventions.Not(never),
+		image:     plain,
+		expects:   true,
+	}, {
+		name:      "image label",
+		predicate: conventions.HasImageLabel("app.kubernetes.io/name"),
+		image:     plain,
+		expects:   true,
+	}, {
+		name:      "image label with value",
+		predicate: conventions.HasImageLabel("app.kubernetes.io/name", "other", "petclinic"),
+		image:     plain,
+		expects:   true,
+	}, {
+		name:      "image label with other value",
+		predicate: conventions.HasImageLabel("app.kubernetes.io/name", "other"),
+		image:     plain,
+		expects:   false,
+	}, {
+		name:      "missing image label",
+		predicate: conventions.HasImageLabel("app.kubernetes.io/version"),
+		image:     plain,
+		expects:   false,
+	}, {
+		name:      "image without labels",
+		predicate: conventions.HasImageLabel("app.kubernetes.io/name"),
+		image:     boot,
+		expects:   false,
+	}, {
+		name:      "dependency",
+		predicate: conventions.HasDependency("spring-boot"),
+		image:     boot,
+		expects:   true,
+	}, {
+		name:      "any dependency",
+		predicate: conventions.HasDependency("quarkus", "spring-web"),
+		image:     boot,
+		expects:   true,
+	}, {
+		name:      "missing dependency",
+		predicate: conventions.HasDependency("quarkus"),
+		image:     boot,
+		expects:   false,
+	}, {
+		name:      "dependency without boms",
+		predicate: conventions.HasDependency("spring-boot"),
+		image:     plain,
+		expects:   false,
+	}, {
+		name:      "dependency with a bom that is not cyclonedx",
+		predicate: conventions.HasDependency("spring-boot"),
+		image:     notCycloneDX,
+		expects:   false,
+	}, {
+		name:      "composed",
+		predicate: conventions.And(conventions.HasDependency("spring-boot"), conventions.Not(conventions.HasImageLabel("app.kubernetes.io/name"))),
+		image:     boot,
+		expects:   true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := evaluate(t, test.predicate, test.image); actual != test.expects {
+				t.Errorf("expected %t, got %t", test.expects, actual)
+			}
+		})
+	}
+}
+
+func TestPredicatesWithoutContainer(t *testing.T) {
+	ctx := context.Background()
+	metadata := conventions.ImageMetadata{}
+	if conventions.HasImageLabel("app.kubernetes.io/name")(ctx, metadata) {
+		t.Errorf("HasImageLabel() expected not to apply without a container")
+	}
+	if conventions.HasDependency("spring-boot")(ctx, metadata) {
+		t.Errorf("HasDependency() expected not to apply without a container")
+	}
+}
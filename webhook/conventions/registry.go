@@ -0,0 +1,184 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conventions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/cartographer-conventions/webhook"
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+)
+
+// ContainerStash parses metadata for a container into the context before the container's
+// conventions are applied, so each convention doesn't parse it again.
+type ContainerStash struct {
+	Stash func(ctx context.Context, container *corev1.Container, image webhookv1alpha1.ImageConfig) (context.Context, error)
+	// Done, if set, is called with the stashed context after the container's conventions are
+	// applied, for example to write properties changed by conventions back to the container.
+	Done func(ctx context.Context, container *corev1.Container) error
+}
+
+// Registry applies the registered conventions to a pod. The zero value is ready to use.
+//
+// Conventions are applied to each container with image metadata, in the order they are
+// registered, then pod conventions are applied to the pod. The ids of the conventions that
+// applied are reported once each, in the order they were first applied. Conventions the
+// PodIntent excludes, per webhook.ExcludedConventions, are not applied.
+type Registry struct {
+	ids            map[string]bool
+	conventions    []Convention
+	podConventions []PodConvention
+	stashes        []ContainerStash
+}
+
+// Register adds conventions applied to each container. Ids must be unique within the
+// registry.
+func (r *Registry) Register(conventions ...Convention) {
+	for _, c := range conventions {
+		r.addId(c.GetId())
+		r.conventions = append(r.conventions, c)
+	}
+}
+
+// RegisterPodConvention adds conventions applied once to the pod. Ids must be unique within
+// the registry.
+func (r *Registry) RegisterPodConvention(conventions ...PodConvention) {
+	for _, c := range conventions {
+		r.addId(c.GetId())
+		r.podConventions = append(r.podConventions, c)
+	}
+}
+
+// RegisterStash adds a stash prepared for each container. The container, its image config and
+// the image's CycloneDX BOMs are always stashed.
+func (r *Registry) RegisterStash(stashes ...ContainerStash) {
+	r.stashes = append(r.stashes, stashes...)
+}
+
+func (r *Registry) addId(id string) {
+	if r.ids == nil {
+		r.ids = map[string]bool{}
+	}
+	if r.ids[id] {
+		panic(fmt.Errorf("conventions: multiple registrations for %q", id))
+	}
+	r.ids[id] = true
+}
+
+// ApplyContext applies the conventions to the template, with values stashed in the context
+// available to every convention, it is a webhook.ContextConvention.
+func (r *Registry) ApplyContext(ctx context.Context, template *corev1.PodTemplateSpec, images []webhookv1alpha1.ImageConfig) ([]string, error) {
+	metadata := ImageMetadata{}
+	for _, config := range images {
+		metadata[config.Image] = config
+	}
+
+	applied := &appliedConventions{seen: map[string]bool{}, excluded: map[string]bool{}}
+	for _, id := range webhook.ExcludedConventions(ctx) {
+		applied.excluded[id] = true
+	}
+	// containers are tracked by name, conventions may add containers to the pod
+	names := make([]string, len(template.Spec.Containers))
+	for i, container := range template.Spec.Containers {
+		names[i] = container.Name
+	}
+	for _, name := range names {
+		container := findContainer(template, name)
+		if container == nil {
+			// removed by an earlier convention
+			continue
+		}
+		image, ok := metadata[container.Image]
+		if !ok {
+			// skip containers without metadata, this may be a container without an image
+			continue
+		}
+		if err := r.applyContainer(ctx, template, name, image, metadata, applied); err != nil {
+			return nil, err
+		}
+	}
+	for _, c := range r.podConventions {
+		if applied.excluded[c.GetId()] || !c.IsApplicable(ctx, metadata) {
+			continue
+		}
+		applied.add(c.GetId())
+		if err := c.ApplyPodConvention(ctx, template, metadata); err != nil {
+			return nil, fmt.Errorf("convention %q failed: %w", c.GetId(), err)
+		}
+	}
+	return applied.ids, nil
+}
+
+func (r *Registry) applyContainer(ctx context.Context, template *corev1.PodTemplateSpec, name string, image webhookv1alpha1.ImageConfig, metadata ImageMetadata, applied *appliedConventions) error {
+	ctx = stashContainer(ctx, template, name)
+	ctx = stashImageConfig(ctx, image)
+	ctx = stashCycloneDXBOMs(ctx, image.BOMs)
+	for _, s := range r.stashes {
+		var err error
+		if ctx, err = s.Stash(ctx, GetContainer(ctx), image); err != nil {
+			return fmt.Errorf("failed to stash metadata for container %q: %w", name, err)
+		}
+	}
+
+	for _, c := range r.conventions {
+		if applied.excluded[c.GetId()] || !c.IsApplicable(ctx, metadata) {
+			continue
+		}
+		containerIdx := slices.IndexFunc(template.Spec.Containers, func(container corev1.Container) bool { return container.Name == name })
+		if containerIdx < 0 {
+			// removed by an earlier convention
+			return nil
+		}
+		applied.add(c.GetId())
+		if err := c.ApplyConvention(ctx, template, containerIdx, metadata); err != nil {
+			return fmt.Errorf("convention %q failed for container %q: %w", c.GetId(), name, err)
+		}
+	}
+
+	container := GetContainer(ctx)
+	if container == nil {
+		return nil
+	}
+	for _, s := range r.stashes {
+		if s.Done == nil {
+			continue
+		}
+		if err := s.Done(ctx, container); err != nil {
+			return fmt.Errorf("failed to update container %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+type appliedConventions struct {
+	ids  []string
+	seen map[string]bool
+	// excluded ids are not applied
+	excluded map[string]bool
+}
+
+func (a *appliedConventions) add(id string) {
+	if a.seen[id] {
+		return
+	}
+	a.seen[id] = true
+	a.ids = append(a.ids, id)
+}
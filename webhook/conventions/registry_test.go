@@ -0,0 +1,416 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conventions_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/vmware-tanzu/cartographer-conventions/webhook"
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+	"github.com/vmware-tanzu/cartographer-conventions/webhook/conventions"
+)
+
+// addEnv is a convention adding an env var to the container.
+func addEnv(id, name, value string) *conventions.BasicConvention {
+	return &conventions.BasicConvention{
+		Id: id,
+		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
+			c := &target.Spec.Containers[containerIdx]
+			c.Env = append(c.Env, corev1.EnvVar{Name: name, Value: value})
+			return nil
+		},
+	}
+}
+
+type stashedName string
+
+func TestRegistryApplyContext(t *testing.T) {
+	image := webhookv1alpha1.ImageConfig{Image: "registry.example/app@sha256:0000"}
+	sidecar := corev1.Container{Name: "sidecar", Image: "registry.example/sidecar"}
+	newTemplate := func() *corev1.PodTemplateSpec {
+		return &corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{Name: "workload", Image: image.Image},
+					{Name: "no-metadata", Image: "registry.example/other"},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		registry        func() *conventions.Registry
+		images          []webhookv1alpha1.ImageConfig
+		expectsTemplate func() *corev1.PodTemplateSpec
+		expectsIds      []string
+		expectsErr      bool
+	}{{
+		name:            "empty registry",
+		registry:        func() *conventions.Registry { return &conventions.Registry{} },
+		images:          []webhookv1alpha1.ImageConfig{image},
+		expectsTemplate: newTemplate,
+	}, {
+		name: "conventions in registration order",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.Register(addEnv("first", "ORDER", "first"), addEnv("second", "ORDER", "second"))
+			return r
+		},
+		images: []webhookv1alpha1.ImageConfig{image},
+		expectsTemplate: func() *corev1.PodTemplateSpec {
+			t := newTemplate()
+			t.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "ORDER", Value: "first"}, {Name: "ORDER", Value: "second"}}
+			return t
+		},
+		expectsIds: []string{"first", "second"},
+	}, {
+		name: "containers without metadata are skipped",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.Register(addEnv("env", "KEY", "value"))
+			return r
+		},
+		expectsTemplate: newTemplate,
+	}, {
+		name: "inapplicable conventions are skipped",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			never := addEnv("never", "NEVER", "true")
+			never.Applicable = func(ctx context.Context, metadata conventions.ImageMetadata) bool { return false }
+			r.Register(never, addEnv("env", "KEY", "value"))
+			return r
+		},
+		images: []webhookv1alpha1.ImageConfig{image},
+		expectsTemplate: func() *corev1.PodTemplateSpec {
+			t := newTemplate()
+			t.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "KEY", Value: "value"}}
+			return t
+		},
+		expectsIds: []string{"env"},
+	}, {
+		name: "ids applied to several containers are reported once",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.Register(addEnv("env", "KEY", "value"))
+			return r
+		},
+		images: []webhookv1alpha1.ImageConfig{image, {Image: "registry.example/other"}},
+		expectsTemplate: func() *corev1.PodTemplateSpec {
+			t := newTemplate()
+			t.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "KEY", Value: "value"}}
+			t.Spec.Containers[1].Env = []corev1.EnvVar{{Name: "KEY", Value: "value"}}
+			return t
+		},
+		expectsIds: []string{"env"},
+	}, {
+		name: "pod conventions after container conventions",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.RegisterPodConvention(&conventions.BasicPodConvention{
+				Id: "label",
+				Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, metadata conventions.ImageMetadata) error {
+					if conventions.GetContainer(ctx) != nil {
+						return fmt.Errorf("expected no container for a pod convention")
+					}
+					target.Labels = map[string]string{"containers": fmt.Sprint(len(target.Spec.Containers[0].Env))}
+					return nil
+				},
+			})
+			r.Register(addEnv("env", "KEY", "value"))
+			return r
+		},
+		images: []webhookv1alpha1.ImageConfig{image},
+		expectsTemplate: func() *corev1.PodTemplateSpec {
+			t := newTemplate()
+			t.Labels = map[string]string{"containers": "1"}
+			t.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "KEY", Value: "value"}}
+			return t
+		},
+		expectsIds: []string{"env", "label"},
+	}, {
+		name: "convention adding a container",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.Register(&conventions.BasicConvention{
+				Id: "sidecar",
+				Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
+					target.Spec.Containers = append(target.Spec.Containers, sidecar)
+					return nil
+				},
+			}, &conventions.BasicConvention{
+				Id: "container",
+				Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
+					container := conventions.GetContainer(ctx)
+					container.Env = append(container.Env, corev1.EnvVar{Name: "KEY", Value: "value"})
+					return nil
+				},
+			})
+			return r
+		},
+		images: []webhookv1alpha1.ImageConfig{image},
+		expectsTemplate: func() *corev1.PodTemplateSpec {
+			t := newTemplate()
+			t.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "KEY", Value: "value"}}
+			t.Spec.Containers = append(t.Spec.Containers, sidecar)
+			return t
+		},
+		expectsIds: []string{"sidecar", "container"},
+	}, {
+		name: "convention removing a container",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.Register(&conventions.BasicConvention{
+				Id: "remove",
+				Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
+					target.Spec.Containers = target.Spec.Containers[:containerIdx]
+					return nil
+				},
+			}, addEnv("env", "KEY", "value"))
+			return r
+		},
+		images: []webhookv1alpha1.ImageConfig{image},
+		expectsTemplate: func() *corev1.PodTemplateSpec {
+			return &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{}}}
+		},
+		expectsIds: []string{"remove"},
+	}, {
+		name: "stashed values",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.RegisterStash(conventions.ContainerStash{
+				Stash: func(ctx context.Context, container *corev1.Container, image webhookv1alpha1.ImageConfig) (context.Context, error) {
+					return conventions.StashValue(ctx, stashedName(container.Name+"@"+image.Image)), nil
+				},
+			})
+			r.Register(&conventions.BasicConvention{
+				Id: "stashed",
+				Applicable: func(ctx context.Context, metadata conventions.ImageMetadata) bool {
+					_, ok := conventions.GetValue[stashedName](ctx)
+					return ok
+				},
+				Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
+					name, _ := conventions.GetValue[stashedName](ctx)
+					c := &target.Spec.Containers[containerIdx]
+					c.Env = append(c.Env, corev1.EnvVar{Name: "STASHED", Value: string(name)})
+					return nil
+				},
+			})
+			return r
+		},
+		images: []webhookv1alpha1.ImageConfig{image},
+		expectsTemplate: func() *corev1.PodTemplateSpec {
+			t := newTemplate()
+			t.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "STASHED", Value: "workload@" + image.Image}}
+			return t
+		},
+		expectsIds: []string{"stashed"},
+	}, {
+		name: "done after the container's conventions",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.RegisterStash(conventions.ContainerStash{
+				Stash: func(ctx context.Context, container *corev1.Container, image webhookv1alpha1.ImageConfig) (context.Context, error) {
+					return conventions.StashValue(ctx, &[]string{}), nil
+				},
+				Done: func(ctx context.Context, container *corev1.Container) error {
+					applied, _ := conventions.GetValue[*[]string](ctx)
+					container.Args = append(container.Args, *applied...)
+					return nil
+				},
+			})
+			for _, id := range []string{"first", "second"} {
+				r.Register(&conventions.BasicConvention{
+					Id: id,
+					Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
+						applied, _ := conventions.GetValue[*[]string](ctx)
+						*applied = append(*applied, "--"+id)
+						// the container moves as the slice grows, done must still update it
+						target.Spec.Containers = append(target.Spec.Containers, corev1.Container{Name: id})
+						return nil
+					},
+				})
+			}
+			return r
+		},
+		images: []webhookv1alpha1.ImageConfig{image},
+		expectsTemplate: func() *corev1.PodTemplateSpec {
+			t := newTemplate()
+			t.Spec.Containers[0].Args = []string{"--first", "--second"}
+			t.Spec.Containers = append(t.Spec.Containers, corev1.Container{Name: "first"}, corev1.Container{Name: "second"})
+			return t
+		},
+		expectsIds: []string{"first", "second"},
+	}, {
+		name: "stash error",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.RegisterStash(conventions.ContainerStash{
+				Stash: func(ctx context.Context, container *corev1.Container, image webhookv1alpha1.ImageConfig) (context.Context, error) {
+					return nil, fmt.Errorf("stash failed")
+				},
+			})
+			r.Register(addEnv("env", "KEY", "value"))
+			return r
+		},
+		images:     []webhookv1alpha1.ImageConfig{image},
+		expectsErr: true,
+	}, {
+		name: "done error",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.RegisterStash(conventions.ContainerStash{
+				Stash: func(ctx context.Context, container *corev1.Container, image webhookv1alpha1.ImageConfig) (context.Context, error) {
+					return ctx, nil
+				},
+				Done: func(ctx context.Context, container *corev1.Container) error {
+					return fmt.Errorf("done failed")
+				},
+			})
+			return r
+		},
+		images:     []webhookv1alpha1.ImageConfig{image},
+		expectsErr: true,
+	}, {
+		name: "convention error",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.Register(&conventions.BasicConvention{
+				Id: "failing",
+				Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, containerIdx int, metadata conventions.ImageMetadata) error {
+					return fmt.Errorf("convention failed")
+				},
+			})
+			return r
+		},
+		images:     []webhookv1alpha1.ImageConfig{image},
+		expectsErr: true,
+	}, {
+		name: "pod convention error",
+		registry: func() *conventions.Registry {
+			r := &conventions.Registry{}
+			r.RegisterPodConvention(&conventions.BasicPodConvention{
+				Id: "failing",
+				Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, metadata conventions.ImageMetadata) error {
+					return fmt.Errorf("convention failed")
+				},
+			})
+			return r
+		},
+		expectsErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			template := newTemplate()
+			ids, err := test.registry().ApplyContext(context.Background(), template, test.images)
+			if (err != nil) != test.expectsErr {
+				t.Fatalf("ApplyContext() expected error %t, got %v", test.expectsErr, err)
+			}
+			if test.expectsErr {
+				return
+			}
+			if !reflect.DeepEqual(ids, test.expectsIds) {
+				t.Errorf("expected applied conventions %v, got %v", test.expectsIds, ids)
+			}
+			if expected := test.expectsTemplate(); !reflect.DeepEqual(template, expected) {
+				t.Errorf("expected template %+v, got %+v", expected, template)
+			}
+		})
+	}
+}
+
+func TestRegistryDuplicateId(t *testing.T) {
+	tests := []struct {
+		name     string
+		register func(r *conventions.Registry)
+	}{{
+		name: "conventions",
+		register: func(r *conventions.Registry) {
+			r.Register(addEnv("env", "KEY", "value"), addEnv("env", "OTHER", "value"))
+		},
+	}, {
+		name: "convention and pod convention",
+		register: func(r *conventions.Registry) {
+			r.Register(addEnv("env", "KEY", "value"))
+			r.RegisterPodConvention(&conventions.BasicPodConvention{Id: "env"})
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected registering a duplicate id to panic")
+				}
+			}()
+			test.register(&conventions.Registry{})
+		})
+	}
+}
+
+func TestRegistryExcludedConventions(t *testing.T) {
+	registry := &conventions.Registry{}
+	registry.Register(addEnv("env", "KEY", "value"), addEnv("excluded", "EXCLUDED", "true"))
+	registry.RegisterPodConvention(&conventions.BasicPodConvention{
+		Id: "excluded-pod",
+		Apply: func(ctx context.Context, target *corev1.PodTemplateSpec, metadata conventions.ImageMetadata) error {
+			target.Labels = map[string]string{"excluded": "true"}
+			return nil
+		},
+	})
+
+	image := webhookv1alpha1.ImageConfig{Image: "registry.example/app@sha256:0000"}
+	body, err := json.Marshal(&webhookv1alpha1.PodConventionContext{
+		Spec: webhookv1alpha1.PodConventionContextSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "workload", Image: image.Image}}},
+			},
+			ImageConfig:         []webhookv1alpha1.ImageConfig{image},
+			ExcludedConventions: []string{"excluded", "excluded-pod"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to marshal request: %v", err)
+	}
+	w := httptest.NewRecorder()
+	webhook.ContextConventionHandler(context.Background(), registry.ApplyContext)(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	response := &webhookv1alpha1.PodConventionContext{}
+	if err := json.NewDecoder(w.Body).Decode(response); err != nil {
+		t.Fatalf("unable to decode response: %v", err)
+	}
+	if expected := []string{"env"}; !reflect.DeepEqual(response.Status.AppliedConventions, expected) {
+		t.Errorf("expected applied conventions %v, got %v", expected, response.Status.AppliedConventions)
+	}
+	if expected := []corev1.EnvVar{{Name: "KEY", Value: "value"}}; !reflect.DeepEqual(response.Status.Template.Spec.Containers[0].Env, expected) {
+		t.Errorf("expected env %v, got %v", expected, response.Status.Template.Spec.Containers[0].Env)
+	}
+	if response.Status.Template.Labels != nil {
+		t.Errorf("expected the excluded pod convention not to apply, got labels %v", response.Status.Template.Labels)
+	}
+}
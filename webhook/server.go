@@ -17,21 +17,28 @@ limitations under the License.
 package webhook
 
 import (
-	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
-	"io"
-	"net"
+	"errors"
+	"fmt"
 	"net/http"
 	"os"
-	"path/filepath"
+	"runtime/debug"
 	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"gomodules.xyz/jsonpatch/v2"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
 )
@@ -40,74 +47,232 @@ const (
 	CertMountPath = "/config/certs"
 )
 
+// tracer uses the global provider, spans are dropped unless the convention server configures one.
+var tracer = otel.Tracer("github.com/vmware-tanzu/cartographer-conventions/webhook")
+
 type Convention func(*corev1.PodTemplateSpec, []webhookv1alpha1.ImageConfig) ([]string, error)
+
+// ContextConvention is a Convention that receives the request's context, which is canceled
+// when the request is or times out. The context describes the PodIntent with
+// PodIntentMetadata, carries the convention's Params, Resources and ExcludedConventions, and
+// may be used to AddWarning.
+type ContextConvention func(context.Context, *corev1.PodTemplateSpec, []webhookv1alpha1.ImageConfig) ([]string, error)
+
+type podIntentMetadataKey struct{}
+
+// PodIntentMetadata returns the namespace, labels, annotations and owner references of the
+// PodIntent conventions are applied to. The name is the PodIntent's name followed by the
+// ClusterPodConvention's name. Controllers that predate this only send the name.
+func PodIntentMetadata(ctx context.Context) metav1.ObjectMeta {
+	meta, _ := ctx.Value(podIntentMetadataKey{}).(metav1.ObjectMeta)
+	return meta
+}
+
+type paramsKey struct{}
+
+// Params returns the params configured for the ClusterPodConvention, with overrides from the
+// PodIntent's namespace applied. Params are nil when none are configured.
+func Params(ctx context.Context) map[string]string {
+	params, _ := ctx.Value(paramsKey{}).(map[string]string)
+	return params
+}
+
+type resourcesKey struct{}
+
+// Resources returns the resources of the kind declared by the ClusterPodConvention, as read by
+// the controller from the PodIntent's namespace. Secrets only include their metadata.
+func Resources(ctx context.Context, apiVersion, kind string) []unstructured.Unstructured {
+	resources, _ := ctx.Value(resourcesKey{}).([]unstructured.Unstructured)
+	var matched []unstructured.Unstructured
+	for _, r := range resources {
+		if r.GetAPIVersion() == apiVersion && r.GetKind() == kind {
+			matched = append(matched, r)
+		}
+	}
+	return matched
+}
+
+type excludedConventionsKey struct{}
+
+// ExcludedConventions returns the ids of the conventions the PodIntent excludes, conventions
+// with these ids should not be applied. The conventions.Registry skips them.
+func ExcludedConventions(ctx context.Context) []string {
+	excluded, _ := ctx.Value(excludedConventionsKey{}).([]string)
+	return excluded
+}
+
 type ImageConfig = webhookv1alpha1.ImageConfig
 
-func NewConventionServer(ctx context.Context, addr string) error {
-	http.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-	})
+// ConventionError is returned by a convention to describe its failure to the controller.
+type ConventionError = webhookv1alpha1.ConventionError
+
+// NewConventionServer serves the handlers registered on http.DefaultServeMux at the address.
+// Use NewServer for a server with its own mux.
+func NewConventionServer(ctx context.Context, addr string, opts ...ConventionServerOption) error {
+	opts = append([]ConventionServerOption{WithAddr(addr), WithServeMux(http.DefaultServeMux)}, opts...)
+	return NewServer(opts...).Start(ctx)
+}
+
+// ConventionHandlerOption customizes the handler created by ConventionHandler.
+type ConventionHandlerOption func(*conventionHandlerOptions)
 
-	watcher := certWatcher{
-		CrtFile: filepath.Join(CertMountPath, "tls.crt"),
-		KeyFile: filepath.Join(CertMountPath, "tls.key"),
+type conventionHandlerOptions struct {
+	jsonPatch       bool
+	maxRequestBytes int64
+	timeout         time.Duration
+}
+
+// WithMaxRequestBytes rejects requests with a body larger than the limit, 64 MiB by default.
+// Requests include the SBOMs of each image, sized by the controller's --sbom-max-image-bytes.
+func WithMaxRequestBytes(limit int64) ConventionHandlerOption {
+	return func(o *conventionHandlerOptions) {
+		o.maxRequestBytes = limit
 	}
-	if err := watcher.Load(ctx); err != nil {
-		return err
+}
+
+// WithTimeout cancels the convention's context when it has not completed within the timeout,
+// thirty seconds by default, and responds with a retryable error. Zero disables the timeout.
+func WithTimeout(timeout time.Duration) ConventionHandlerOption {
+	return func(o *conventionHandlerOptions) {
+		o.timeout = timeout
 	}
-	go watcher.Watch(ctx)
-
-	server := &http.Server{
-		Addr: addr,
-		TLSConfig: &tls.Config{
-			GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
-				cert := watcher.GetCertificate()
-				return cert, nil
-			},
-			PreferServerCipherSuites: true,
-			MinVersion:               tls.VersionTLS13,
-		},
-		BaseContext: func(_ net.Listener) context.Context {
-			return ctx
-		},
+}
+
+// WithJSONPatchResponse responds with an RFC 6902 JSON Patch of the changes made by the
+// convention, rather than echoing the full template and request.
+func WithJSONPatchResponse() ConventionHandlerOption {
+	return func(o *conventionHandlerOptions) {
+		o.jsonPatch = true
 	}
-	go func() {
-		<-ctx.Done()
-		server.Close()
-	}()
+}
+
+func ConventionHandler(ctx context.Context, convention Convention, opts ...ConventionHandlerOption) func(http.ResponseWriter, *http.Request) {
+	return ContextConventionHandler(ctx, func(_ context.Context, template *corev1.PodTemplateSpec, images []ImageConfig) ([]string, error) {
+		return convention(template, images)
+	}, opts...)
+}
+
+// ContextConventionHandler serves a ContextConvention, like ConventionHandler.
+func ContextConventionHandler(ctx context.Context, convention ContextConvention, opts ...ConventionHandlerOption) func(http.ResponseWriter, *http.Request) {
+	options := newConventionHandlerOptions(opts)
+	return handle(ctx, options, func(conventionCtx context.Context, wc *webhookv1alpha1.PodConventionContext) error {
+		pts := wc.Spec.Template.DeepCopy()
+		appliedConventions, err := runWithContext(conventionCtx, func(ctx context.Context) ([]string, error) {
+			return convention(ctx, pts, wc.Spec.ImageConfig)
+		})
+		if err != nil {
+			// the template may be partially updated, only report the error
+			return err
+		}
+		wc.Status.AppliedConventions = appliedConventions
+		if options.jsonPatch {
+			patch, err := createPatch(&wc.Spec.Template, pts)
+			if err != nil {
+				return fmt.Errorf("failed to create the JSON patch: %w", err)
+			}
+			patchType := webhookv1alpha1.JSONPatchType
+			wc.Status.Patch = patch
+			wc.Status.PatchType = &patchType
+			// the controller only reads the status, avoid echoing the request
+			wc.Spec = webhookv1alpha1.PodConventionContextSpec{}
+		} else {
+			wc.Status.Template = *pts
+		}
+		return nil
+	})
+}
+
+// ValidationReason describes why a Validator denies a template.
+type ValidationReason = webhookv1alpha1.ValidationReason
+
+// Validator decides whether the template complies with a policy, for a ClusterPodConvention in
+// Validate mode. Validators see the template after all mutating conventions are applied, the
+// template is allowed when no reasons are returned. Like a ContextConvention, the context
+// describes the PodIntent and may be used to AddWarning. Changes to the template are ignored.
+type Validator func(context.Context, *corev1.PodTemplateSpec, []ImageConfig) ([]ValidationReason, error)
+
+// ValidatorHandler serves a Validator, with the same options as ConventionHandler.
+func ValidatorHandler(ctx context.Context, validator Validator, opts ...ConventionHandlerOption) func(http.ResponseWriter, *http.Request) {
+	options := newConventionHandlerOptions(opts)
+	return handle(ctx, options, func(conventionCtx context.Context, wc *webhookv1alpha1.PodConventionContext) error {
+		pts := wc.Spec.Template.DeepCopy()
+		reasons, err := runWithContext(conventionCtx, func(ctx context.Context) ([]ValidationReason, error) {
+			return validator(ctx, pts, wc.Spec.ImageConfig)
+		})
+		if err != nil {
+			return err
+		}
+		wc.Status.Validation = &webhookv1alpha1.ConventionValidation{
+			Allowed: len(reasons) == 0,
+			Reasons: reasons,
+		}
+		// the controller only reads the validation, avoid echoing the request
+		wc.Spec = webhookv1alpha1.PodConventionContextSpec{}
+		return nil
+	})
+}
 
-	return server.ListenAndServeTLS("", "")
+func newConventionHandlerOptions(opts []ConventionHandlerOption) conventionHandlerOptions {
+	options := conventionHandlerOptions{
+		maxRequestBytes: 64 << 20,
+		timeout:         30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
 }
 
-func ConventionHandler(ctx context.Context, convention Convention) func(http.ResponseWriter, *http.Request) {
+// handle decodes the request, prepares the context for the convention and encodes the response
+// after serve updates the status, or the error returned by serve.
+func handle(ctx context.Context, options conventionHandlerOptions, serve func(context.Context, *webhookv1alpha1.PodConventionContext) error) func(http.ResponseWriter, *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := logr.FromContextOrDiscard(ctx)
+		// continue the trace started by the controller, if any
+		spanCtx, span := tracer.Start(
+			otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header)),
+			"ConventionHandler",
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("url.path", r.URL.Path)),
+		)
+		defer span.End()
 
 		logger.Info("received request")
 		wc := &webhookv1alpha1.PodConventionContext{}
 		if r.Body != nil {
-			reqBody, err := io.ReadAll(r.Body)
-			if err != nil {
-				logger.Error(err, "error reading request body")
-				w.WriteHeader(http.StatusBadRequest)
-				return
-			}
-			decoder := json.NewDecoder(bytes.NewBuffer(reqBody))
+			decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, options.maxRequestBytes))
 			if derr := decoder.Decode(wc); derr != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(derr, &maxBytesErr) {
+					logger.Error(derr, "the request body is too large", "limit", maxBytesErr.Limit)
+					writeError(ctx, w, wc, &ConventionError{Reason: webhookv1alpha1.RequestTooLargeReason, Message: fmt.Sprintf("request body is larger than %d bytes", maxBytesErr.Limit)})
+					return
+				}
 				logger.Error(derr, "the request body could not be decoded into a PodConventionContext type")
-				w.WriteHeader(http.StatusBadRequest)
+				writeError(ctx, w, wc, &ConventionError{Reason: webhookv1alpha1.InvalidRequestReason, Message: fmt.Sprintf("request body is not a PodConventionContext: %v", derr)})
 				return
 			}
 		}
+		span.SetAttributes(attribute.String("podconventioncontext.name", wc.Name))
 		w.Header().Set("Content-Type", "application/json")
-		pts := wc.Spec.Template.DeepCopy()
-		appliedConventions, err := convention(pts, wc.Spec.ImageConfig)
-		if err != nil {
+		conventionCtx, warnings := withWarnings(logr.NewContext(spanCtx, logger))
+		conventionCtx = context.WithValue(conventionCtx, podIntentMetadataKey{}, *wc.ObjectMeta.DeepCopy())
+		conventionCtx = context.WithValue(conventionCtx, paramsKey{}, wc.Spec.Params)
+		conventionCtx = context.WithValue(conventionCtx, resourcesKey{}, wc.Spec.Resources)
+		conventionCtx = context.WithValue(conventionCtx, excludedConventionsKey{}, wc.Spec.ExcludedConventions)
+		if options.timeout > 0 {
+			var cancel context.CancelFunc
+			conventionCtx, cancel = context.WithTimeout(conventionCtx, options.timeout)
+			defer cancel()
+		}
+		if err := serve(conventionCtx, wc); err != nil {
 			logger.Error(err, "error applying conventions")
-			w.WriteHeader(http.StatusInternalServerError)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			writeError(ctx, w, wc, err)
+			return
 		}
-		wc.Status.AppliedConventions = appliedConventions
-		wc.Status.Template = *pts
+		wc.Status.Warnings = warnings.list()
 		if err := json.NewEncoder(w).Encode(wc); err != nil {
 			logger.Error(err, "failed to encode the PodConventionContext. Unable to create response for received request.")
 			return
@@ -115,12 +280,110 @@ func ConventionHandler(ctx context.Context, convention Convention) func(http.Res
 	}
 }
 
+// runWithContext runs the convention, returning once the context is done even when the
+// convention ignores it. Values shared with the convention must not be used after the context
+// is done, the convention may still be changing them.
+func runWithContext[T any](ctx context.Context, convention func(context.Context) (T, error)) (T, error) {
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		value, err := runConvention(ctx, convention)
+		done <- result{value: value, err: err}
+	}()
+	select {
+	case res := <-done:
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero T
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return zero, &ConventionError{
+				Reason:    webhookv1alpha1.ConventionTimeoutReason,
+				Message:   "convention did not complete in time",
+				Retryable: true,
+			}
+		}
+		return zero, ctx.Err()
+	}
+}
+
+// runConvention runs the convention, recovering from panics as a ConventionError.
+func runConvention[T any](ctx context.Context, convention func(context.Context) (T, error)) (_ T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logr.FromContextOrDiscard(ctx).Error(fmt.Errorf("%v", r), "convention panicked", "stack", string(debug.Stack()))
+			err = &ConventionError{
+				Reason:  webhookv1alpha1.ConventionPanicReason,
+				Message: fmt.Sprintf("convention panicked: %v", r),
+			}
+		}
+	}()
+	return convention(ctx)
+}
+
+// writeError responds with the error in the status of the context. Errors that are not
+// retryable are Unprocessable Entity, so controllers that predate structured errors do not
+// retry them as eagerly, except for a panic which is a bug in the convention server.
+func writeError(ctx context.Context, w http.ResponseWriter, wc *webhookv1alpha1.PodConventionContext, err error) {
+	var conventionErr *ConventionError
+	if !errors.As(err, &conventionErr) {
+		conventionErr = &ConventionError{
+			Reason:    webhookv1alpha1.ConventionFailedReason,
+			Message:   err.Error(),
+			Retryable: true,
+		}
+	}
+	response := &webhookv1alpha1.PodConventionContext{
+		TypeMeta:   wc.TypeMeta,
+		ObjectMeta: wc.ObjectMeta,
+		Status: webhookv1alpha1.PodConventionContextStatus{
+			Error: conventionErr,
+		},
+	}
+
+	status := http.StatusUnprocessableEntity
+	switch {
+	case conventionErr.Retryable, conventionErr.Reason == webhookv1alpha1.ConventionPanicReason:
+		status = http.StatusInternalServerError
+	case conventionErr.Reason == webhookv1alpha1.InvalidRequestReason:
+		status = http.StatusBadRequest
+	case conventionErr.Reason == webhookv1alpha1.RequestTooLargeReason:
+		status = http.StatusRequestEntityTooLarge
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		logr.FromContextOrDiscard(ctx).Error(err, "failed to encode the error response")
+	}
+}
+
+func createPatch(original, modified *corev1.PodTemplateSpec) ([]byte, error) {
+	originalJSON, err := json.Marshal(original)
+	if err != nil {
+		return nil, err
+	}
+	modifiedJSON, err := json.Marshal(modified)
+	if err != nil {
+		return nil, err
+	}
+	operations, err := jsonpatch.CreatePatch(originalJSON, modifiedJSON)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(operations)
+}
+
 type certWatcher struct {
 	CrtFile string
 	KeyFile string
+	// CAFile is optional, client certificates are verified against the CAs it contains
+	CAFile string
 
-	m       sync.Mutex
-	keyPair *tls.Certificate
+	m         sync.Mutex
+	keyPair   *tls.Certificate
+	clientCAs *x509.CertPool
 }
 
 func (w *certWatcher) Watch(ctx context.Context) error {
@@ -171,6 +434,19 @@ func (w *certWatcher) Load(ctx context.Context) error {
 	}
 	w.keyPair = &keyPair
 	logger.Info("loaded TLS key pair", "not-after", leaf.NotAfter)
+
+	if w.CAFile != "" {
+		ca, err := os.ReadFile(w.CAFile)
+		if err != nil {
+			return err
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(ca) {
+			return fmt.Errorf("no certificates found in client CA file %q", w.CAFile)
+		}
+		w.clientCAs = clientCAs
+		logger.Info("loaded client CAs")
+	}
 	return nil
 }
 
@@ -180,3 +456,10 @@ func (w *certWatcher) GetCertificate() *tls.Certificate {
 
 	return w.keyPair
 }
+
+func (w *certWatcher) GetClientCAs() *x509.CertPool {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	return w.clientCAs
+}
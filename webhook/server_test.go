@@ -0,0 +1,223 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"gomodules.xyz/jsonpatch/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/cartographer-conventions/webhook"
+	webhookv1alpha1 "github.com/vmware-tanzu/cartographer-conventions/webhook/api/v1alpha1"
+)
+
+func TestConventionHandler(t *testing.T) {
+	// release unblocks conventions that ignore their context once the test completes
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+
+	request := &webhookv1alpha1.PodConventionContext{
+		ObjectMeta: metav1.ObjectMeta{Name: "petclinic"},
+		Spec: webhookv1alpha1.PodConventionContextSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "workload", Image: "registry.example/app"}},
+				},
+			},
+		},
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("unable to marshal request: %v", err)
+	}
+	addEnv := func(template *corev1.PodTemplateSpec, images []webhookv1alpha1.ImageConfig) ([]string, error) {
+		template.Spec.Containers[0].Env = append(template.Spec.Containers[0].Env, corev1.EnvVar{Name: "KEY", Value: "value"})
+		return []string{"env"}, nil
+	}
+	expectedTemplate := request.Spec.Template.DeepCopy()
+	expectedTemplate.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "KEY", Value: "value"}}
+
+	tests := []struct {
+		name          string
+		handler       func(ctx context.Context) http.HandlerFunc
+		body          []byte
+		expectsStatus int
+		expectsError  *webhookv1alpha1.ConventionError
+		verify        func(t *testing.T, response *webhookv1alpha1.PodConventionContext)
+	}{{
+		name: "template response",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ConventionHandler(ctx, addEnv)
+		},
+		body:          body,
+		expectsStatus: http.StatusOK,
+		verify: func(t *testing.T, response *webhookv1alpha1.PodConventionContext) {
+			if !reflect.DeepEqual(&response.Status.Template, expectedTemplate) {
+				t.Errorf("expected template %+v, got %+v", expectedTemplate, response.Status.Template)
+			}
+			if expected := []string{"env"}; !reflect.DeepEqual(response.Status.AppliedConventions, expected) {
+				t.Errorf("expected applied conventions %v, got %v", expected, response.Status.AppliedConventions)
+			}
+			if response.Status.PatchType != nil {
+				t.Errorf("expected no patch, got %s", *response.Status.PatchType)
+			}
+		},
+	}, {
+		name: "json patch response",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ConventionHandler(ctx, addEnv, webhook.WithJSONPatchResponse())
+		},
+		body:          body,
+		expectsStatus: http.StatusOK,
+		verify: func(t *testing.T, response *webhookv1alpha1.PodConventionContext) {
+			if response.Status.PatchType == nil || *response.Status.PatchType != webhookv1alpha1.JSONPatchType {
+				t.Fatalf("expected a %s patch type, got %v", webhookv1alpha1.JSONPatchType, response.Status.PatchType)
+			}
+			var operations []jsonpatch.Operation
+			if err := json.Unmarshal(response.Status.Patch, &operations); err != nil {
+				t.Fatalf("unable to decode the patch: %v", err)
+			}
+			if len(operations) != 1 || operations[0].Operation != "add" || operations[0].Path != "/spec/containers/0/env" {
+				t.Errorf("expected an add operation for the env, got %s", response.Status.Patch)
+			}
+			if expected := []string{"env"}; !reflect.DeepEqual(response.Status.AppliedConventions, expected) {
+				t.Errorf("expected applied conventions %v, got %v", expected, response.Status.AppliedConventions)
+			}
+			if len(response.Spec.Template.Spec.Containers) != 0 || len(response.Status.Template.Spec.Containers) != 0 {
+				t.Errorf("expected the template not to be echoed with a patch")
+			}
+		},
+	}, {
+		name: "warnings",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ContextConventionHandler(ctx, func(ctx context.Context, template *corev1.PodTemplateSpec, images []webhookv1alpha1.ImageConfig) ([]string, error) {
+				webhook.AddWarning(ctx, "image %q has no metadata", template.Spec.Containers[0].Image)
+				return nil, nil
+			})
+		},
+		body:          body,
+		expectsStatus: http.StatusOK,
+		verify: func(t *testing.T, response *webhookv1alpha1.PodConventionContext) {
+			if expected := []string{`image "registry.example/app" has no metadata`}; !reflect.DeepEqual(response.Status.Warnings, expected) {
+				t.Errorf("expected warnings %v, got %v", expected, response.Status.Warnings)
+			}
+		},
+	}, {
+		name: "not a pod convention context",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ConventionHandler(ctx, addEnv)
+		},
+		body:          []byte(`[]`),
+		expectsStatus: http.StatusBadRequest,
+		expectsError:  &webhookv1alpha1.ConventionError{Reason: webhookv1alpha1.InvalidRequestReason},
+	}, {
+		name: "oversized body",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ConventionHandler(ctx, addEnv, webhook.WithMaxRequestBytes(16))
+		},
+		body:          body,
+		expectsStatus: http.StatusRequestEntityTooLarge,
+		expectsError:  &webhookv1alpha1.ConventionError{Reason: webhookv1alpha1.RequestTooLargeReason},
+	}, {
+		name: "convention error",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ConventionHandler(ctx, func(template *corev1.PodTemplateSpec, images []webhookv1alpha1.ImageConfig) ([]string, error) {
+				return nil, fmt.Errorf("registry unavailable")
+			})
+		},
+		body:          body,
+		expectsStatus: http.StatusInternalServerError,
+		expectsError:  &webhookv1alpha1.ConventionError{Reason: webhookv1alpha1.ConventionFailedReason, Retryable: true},
+	}, {
+		name: "convention error that is not retryable",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ConventionHandler(ctx, func(template *corev1.PodTemplateSpec, images []webhookv1alpha1.ImageConfig) ([]string, error) {
+				return nil, &webhook.ConventionError{Reason: "UnsupportedRuntime", Field: "spec.containers[0].image"}
+			})
+		},
+		body:          body,
+		expectsStatus: http.StatusUnprocessableEntity,
+		expectsError:  &webhookv1alpha1.ConventionError{Reason: "UnsupportedRuntime"},
+	}, {
+		name: "panicking convention",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ConventionHandler(ctx, func(template *corev1.PodTemplateSpec, images []webhookv1alpha1.ImageConfig) ([]string, error) {
+				var labels map[string]string
+				labels["panic"] = "true"
+				return nil, nil
+			})
+		},
+		body:          body,
+		expectsStatus: http.StatusInternalServerError,
+		expectsError:  &webhookv1alpha1.ConventionError{Reason: webhookv1alpha1.ConventionPanicReason},
+	}, {
+		name: "timed out convention",
+		handler: func(ctx context.Context) http.HandlerFunc {
+			return webhook.ConventionHandler(ctx, func(template *corev1.PodTemplateSpec, images []webhookv1alpha1.ImageConfig) ([]string, error) {
+				// ignores the context
+				<-release
+				return nil, nil
+			}, webhook.WithTimeout(10*time.Millisecond))
+		},
+		body:          body,
+		expectsStatus: http.StatusInternalServerError,
+		expectsError:  &webhookv1alpha1.ConventionError{Reason: webhookv1alpha1.ConventionTimeoutReason, Retryable: true},
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			test.handler(context.Background())(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(test.body)))
+
+			if w.Code != test.expectsStatus {
+				t.Errorf("expected status %d, got %d: %s", test.expectsStatus, w.Code, w.Body.String())
+			}
+			if contentType := w.Header().Get("Content-Type"); contentType != "application/json" {
+				t.Errorf("expected a json response, got %q", contentType)
+			}
+			response := &webhookv1alpha1.PodConventionContext{}
+			if err := json.NewDecoder(w.Body).Decode(response); err != nil {
+				t.Fatalf("unable to decode response: %v", err)
+			}
+
+			if test.expectsError == nil {
+				if response.Status.Error != nil {
+					t.Errorf("unexpected error: %v", response.Status.Error)
+				}
+			} else {
+				if response.Status.Error == nil {
+					t.Fatalf("expected a %s error", test.expectsError.Reason)
+				}
+				if response.Status.Error.Reason != test.expectsError.Reason || response.Status.Error.Retryable != test.expectsError.Retryable {
+					t.Errorf("expected error %s (retryable %t), got %v (retryable %t)", test.expectsError.Reason, test.expectsError.Retryable, response.Status.Error, response.Status.Error.Retryable)
+				}
+			}
+			if test.verify != nil {
+				test.verify(t, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 VMware Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+type warningsKey struct{}
+
+type warnings struct {
+	m        sync.Mutex
+	messages []string
+}
+
+func withWarnings(ctx context.Context) (context.Context, *warnings) {
+	w := &warnings{}
+	return context.WithValue(ctx, warningsKey{}, w), w
+}
+
+func (w *warnings) add(message string) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if !slices.Contains(w.messages, message) {
+		w.messages = append(w.messages, message)
+	}
+}
+
+func (w *warnings) list() []string {
+	w.m.Lock()
+	defer w.m.Unlock()
+	return slices.Clone(w.messages)
+}
+
+// AddWarning tells the developer of the workload something without failing the convention,
+// like a default that could not be applied. Warnings are shown on the PodIntent. The warning
+// is dropped when the context is not from a ContextConventionHandler.
+func AddWarning(ctx context.Context, format string, args ...any) {
+	if w, ok := ctx.Value(warningsKey{}).(*warnings); ok {
+		w.add(fmt.Sprintf(format, args...))
+	}
+}